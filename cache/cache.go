@@ -0,0 +1,338 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Yuukirn/gutils"
+)
+
+type Policy int
+
+const (
+	// LRU evicts the least recently used entry when the cache is full.
+	LRU Policy = iota
+	// LFU evicts the least frequently used entry when the cache is full,
+	// breaking ties by recency.
+	LFU
+	// TTL evicts the entry closest to expiry when the cache is full.
+	TTL
+)
+
+type Config[K comparable, V any] struct {
+	Policy Policy
+	// Capacity bounds the number of entries; zero or negative means unbounded.
+	Capacity int
+	// TTL is the lifetime of an entry after it was set; zero means entries never expire.
+	TTL time.Duration
+	// OnEvict is called for every entry removed by eviction or expiry,
+	// after the cache lock has been released.
+	OnEvict func(k K, v V)
+	// Clock returns the current time; defaults to time.Now.
+	Clock func() time.Time
+}
+
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+type entry[K comparable, V any] struct {
+	key      K
+	value    V
+	expireAt time.Time
+	freq     int
+	elem     *list.Element
+	expElem  *list.Element
+}
+
+type call[V any] struct {
+	wg  sync.WaitGroup
+	res gutils.Result[V]
+}
+
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	cfg     Config[K, V]
+	items   map[K]*entry[K, V]
+	order   *list.List
+	expiry  *list.List
+	freqs   map[int]*list.List
+	minFreq int
+	loads   map[K]*call[V]
+	stats   Stats
+}
+
+func New[K comparable, V any](cfg Config[K, V]) *Cache[K, V] {
+	if cfg.Clock == nil {
+		cfg.Clock = time.Now
+	}
+	return &Cache[K, V]{
+		cfg:    cfg,
+		items:  make(map[K]*entry[K, V]),
+		order:  list.New(),
+		expiry: list.New(),
+		freqs:  make(map[int]*list.List),
+		loads:  make(map[K]*call[V]),
+	}
+}
+
+func (c *Cache[K, V]) Get(k K) gutils.Option[V] {
+	c.mu.Lock()
+	o, evicted := c.get(k)
+	c.mu.Unlock()
+	c.notify(evicted)
+	return o
+}
+
+func (c *Cache[K, V]) Set(k K, v V) {
+	c.mu.Lock()
+	evicted := c.set(k, v)
+	c.mu.Unlock()
+	c.notify(evicted)
+}
+
+func (c *Cache[K, V]) Delete(k K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, exist := c.items[k]
+	if !exist {
+		return false
+	}
+	c.remove(e)
+	return true
+}
+
+// GetOrLoad returns the cached value for k, or calls load to produce it.
+// Concurrent calls for the same missing key share a single call to load.
+// Only successful results are stored.
+func (c *Cache[K, V]) GetOrLoad(k K, load func(k K) gutils.Result[V]) gutils.Result[V] {
+	c.mu.Lock()
+	o, evicted := c.get(k)
+	if o.IsSome() {
+		c.mu.Unlock()
+		c.notify(evicted)
+		return gutils.Ok(o.Some())
+	}
+	if cl, exist := c.loads[k]; exist {
+		c.mu.Unlock()
+		c.notify(evicted)
+		cl.wg.Wait()
+		return cl.res
+	}
+
+	cl := &call[V]{}
+	cl.wg.Add(1)
+	c.loads[k] = cl
+	c.mu.Unlock()
+	c.notify(evicted)
+
+	defer func() {
+		if p := recover(); p != nil {
+			c.mu.Lock()
+			delete(c.loads, k)
+			c.mu.Unlock()
+			cl.res = gutils.Err[V](fmt.Errorf("cache: load panicked: %v", p))
+			cl.wg.Done()
+			panic(p)
+		}
+	}()
+	cl.res = load(k)
+
+	c.mu.Lock()
+	delete(c.loads, k)
+	evicted = nil
+	if cl.res.IsOk() {
+		evicted = c.set(k, cl.res.Unwrap())
+	}
+	c.mu.Unlock()
+	cl.wg.Done()
+	c.notify(evicted)
+
+	return cl.res
+}
+
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[K]*entry[K, V])
+	c.order.Init()
+	c.expiry.Init()
+	c.freqs = make(map[int]*list.List)
+	c.minFreq = 0
+}
+
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+func (c *Cache[K, V]) get(k K) (gutils.Option[V], []*entry[K, V]) {
+	e, exist := c.items[k]
+	if !exist {
+		c.stats.Misses++
+		return gutils.None[V](), nil
+	}
+	if c.expired(e) {
+		c.remove(e)
+		c.stats.Misses++
+		c.stats.Evictions++
+		return gutils.None[V](), []*entry[K, V]{e}
+	}
+
+	c.stats.Hits++
+	c.touch(e)
+	return gutils.Some(e.value), nil
+}
+
+func (c *Cache[K, V]) set(k K, v V) []*entry[K, V] {
+	if e, exist := c.items[k]; exist {
+		e.value = v
+		e.expireAt = c.expireAt()
+		if e.expElem != nil {
+			c.expiry.MoveToBack(e.expElem)
+		}
+		if c.cfg.Policy == TTL {
+			c.order.MoveToBack(e.elem)
+		} else {
+			c.touch(e)
+		}
+		return nil
+	}
+
+	var evicted []*entry[K, V]
+	if c.cfg.Capacity > 0 && len(c.items) >= c.cfg.Capacity {
+		evicted = c.purgeExpired()
+		for len(c.items) >= c.cfg.Capacity {
+			e := c.victim()
+			c.remove(e)
+			c.stats.Evictions++
+			evicted = append(evicted, e)
+		}
+	}
+
+	e := &entry[K, V]{key: k, value: v, expireAt: c.expireAt()}
+	c.items[k] = e
+	if c.cfg.Policy == LFU {
+		e.freq = 1
+		e.elem = c.freqList(1).PushBack(e)
+		c.minFreq = 1
+	} else {
+		e.elem = c.order.PushBack(e)
+	}
+	if c.cfg.TTL > 0 {
+		e.expElem = c.expiry.PushBack(e)
+	}
+	return evicted
+}
+
+// touch records an access to e for the eviction policy.
+func (c *Cache[K, V]) touch(e *entry[K, V]) {
+	switch c.cfg.Policy {
+	case LRU:
+		c.order.MoveToBack(e.elem)
+	case LFU:
+		l := c.freqs[e.freq]
+		l.Remove(e.elem)
+		if l.Len() == 0 {
+			delete(c.freqs, e.freq)
+			if c.minFreq == e.freq {
+				c.minFreq++
+			}
+		}
+		e.freq++
+		e.elem = c.freqList(e.freq).PushBack(e)
+	}
+}
+
+func (c *Cache[K, V]) victim() *entry[K, V] {
+	if c.cfg.Policy == LFU {
+		return c.freqs[c.minFreq].Front().Value.(*entry[K, V])
+	}
+	return c.order.Front().Value.(*entry[K, V])
+}
+
+func (c *Cache[K, V]) remove(e *entry[K, V]) {
+	delete(c.items, e.key)
+	if e.expElem != nil {
+		c.expiry.Remove(e.expElem)
+	}
+	if c.cfg.Policy != LFU {
+		c.order.Remove(e.elem)
+		return
+	}
+
+	l := c.freqs[e.freq]
+	l.Remove(e.elem)
+	if l.Len() > 0 {
+		return
+	}
+	delete(c.freqs, e.freq)
+	if c.minFreq == e.freq {
+		c.minFreq = 0
+		for f := range c.freqs {
+			if c.minFreq == 0 || f < c.minFreq {
+				c.minFreq = f
+			}
+		}
+	}
+}
+
+// purgeExpired removes the expired entries at the front of the expiry list,
+// where they gather because every entry lives for the same TTL. This keeps Set
+// at capacity from scanning the whole cache, and from evicting live entries
+// while expired ones hold their slots.
+func (c *Cache[K, V]) purgeExpired() []*entry[K, V] {
+	var evicted []*entry[K, V]
+	for front := c.expiry.Front(); front != nil; front = c.expiry.Front() {
+		e := front.Value.(*entry[K, V])
+		if !c.expired(e) {
+			break
+		}
+		c.remove(e)
+		c.stats.Evictions++
+		evicted = append(evicted, e)
+	}
+	return evicted
+}
+
+func (c *Cache[K, V]) freqList(freq int) *list.List {
+	l, exist := c.freqs[freq]
+	if !exist {
+		l = list.New()
+		c.freqs[freq] = l
+	}
+	return l
+}
+
+func (c *Cache[K, V]) expireAt() time.Time {
+	if c.cfg.TTL <= 0 {
+		return time.Time{}
+	}
+	return c.cfg.Clock().Add(c.cfg.TTL)
+}
+
+func (c *Cache[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expireAt.IsZero() && !c.cfg.Clock().Before(e.expireAt)
+}
+
+func (c *Cache[K, V]) notify(evicted []*entry[K, V]) {
+	if c.cfg.OnEvict == nil {
+		return
+	}
+	for _, e := range evicted {
+		c.cfg.OnEvict(e.key, e.value)
+	}
+}
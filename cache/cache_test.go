@@ -0,0 +1,317 @@
+package cache
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Yuukirn/gutils"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestCache_Get(t *testing.T) {
+	c := New(Config[string, int]{})
+	c.Set("a", 1)
+
+	if got := c.Get("a"); !reflect.DeepEqual(got, gutils.Some(1)) {
+		t.Errorf("Get() = %v, want %v", got, gutils.Some(1))
+	}
+	if got := c.Get("b"); !reflect.DeepEqual(got, gutils.None[int]()) {
+		t.Errorf("Get() = %v, want %v", got, gutils.None[int]())
+	}
+	if got := c.Stats(); got != (Stats{Hits: 1, Misses: 1}) {
+		t.Errorf("Stats() = %+v, want %+v", got, Stats{Hits: 1, Misses: 1})
+	}
+}
+
+func TestCache_Eviction(t *testing.T) {
+	type testCase struct {
+		name    string
+		policy  Policy
+		ops     func(c *Cache[string, int])
+		evicted []string
+	}
+	tests := []testCase{
+		{
+			name:   "LRUTest",
+			policy: LRU,
+			ops: func(c *Cache[string, int]) {
+				c.Set("a", 1)
+				c.Set("b", 2)
+				c.Get("a")
+				c.Set("c", 3)
+			},
+			evicted: []string{"b"},
+		},
+		{
+			name:   "LFUTest",
+			policy: LFU,
+			ops: func(c *Cache[string, int]) {
+				c.Set("a", 1)
+				c.Set("b", 2)
+				c.Get("a")
+				c.Get("a")
+				c.Get("b")
+				c.Set("c", 3)
+				c.Get("c")
+				c.Set("d", 4)
+			},
+			evicted: []string{"b", "c"},
+		},
+		{
+			name:   "TTLTest",
+			policy: TTL,
+			ops: func(c *Cache[string, int]) {
+				c.Set("a", 1)
+				c.Set("b", 2)
+				c.Get("a")
+				c.Set("c", 3)
+			},
+			evicted: []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted []string
+			c := New(Config[string, int]{
+				Policy:   tt.policy,
+				Capacity: 2,
+				OnEvict:  func(k string, v int) { evicted = append(evicted, k) },
+			})
+			tt.ops(c)
+			if !reflect.DeepEqual(evicted, tt.evicted) {
+				t.Errorf("evicted = %v, want %v", evicted, tt.evicted)
+			}
+			if got := c.Len(); got != 2 {
+				t.Errorf("Len() = %v, want %v", got, 2)
+			}
+		})
+	}
+}
+
+func TestCache_Expiry(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var evicted []string
+	c := New(Config[string, int]{
+		Policy:  TTL,
+		TTL:     time.Minute,
+		Clock:   clock.Now,
+		OnEvict: func(k string, v int) { evicted = append(evicted, k) },
+	})
+	c.Set("a", 1)
+
+	clock.Advance(59 * time.Second)
+	if got := c.Get("a"); !reflect.DeepEqual(got, gutils.Some(1)) {
+		t.Errorf("Get() = %v, want %v", got, gutils.Some(1))
+	}
+
+	clock.Advance(time.Second)
+	if got := c.Get("a"); !reflect.DeepEqual(got, gutils.None[int]()) {
+		t.Errorf("Get() = %v, want %v", got, gutils.None[int]())
+	}
+	if !reflect.DeepEqual(evicted, []string{"a"}) {
+		t.Errorf("evicted = %v, want %v", evicted, []string{"a"})
+	}
+	if got := c.Stats(); got != (Stats{Hits: 1, Misses: 1, Evictions: 1}) {
+		t.Errorf("Stats() = %+v, want %+v", got, Stats{Hits: 1, Misses: 1, Evictions: 1})
+	}
+}
+
+func TestCache_PurgeExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var evicted []string
+	c := New(Config[string, int]{
+		Policy:   TTL,
+		Capacity: 3,
+		TTL:      time.Minute,
+		Clock:    clock.Now,
+		OnEvict:  func(k string, v int) { evicted = append(evicted, k) },
+	})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	clock.Advance(30 * time.Second)
+	c.Set("c", 3)
+	clock.Advance(40 * time.Second)
+	c.Set("d", 4)
+
+	if !reflect.DeepEqual(evicted, []string{"a", "b"}) {
+		t.Errorf("evicted = %v, want %v", evicted, []string{"a", "b"})
+	}
+	if got := c.Len(); got != 2 {
+		t.Errorf("Len() = %v, want %v", got, 2)
+	}
+}
+
+func TestCache_PurgeExpiredAtCapacity(t *testing.T) {
+	type testCase struct {
+		name   string
+		policy Policy
+	}
+	tests := []testCase{
+		{name: "PurgeExpiredAtCapacityTest1", policy: LRU},
+		{name: "PurgeExpiredAtCapacityTest2", policy: LFU},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Unix(0, 0)}
+			var evicted []string
+			c := New(Config[string, int]{
+				Policy:   tt.policy,
+				Capacity: 2,
+				TTL:      time.Minute,
+				Clock:    clock.Now,
+				OnEvict:  func(k string, v int) { evicted = append(evicted, k) },
+			})
+			c.Set("a", 1)
+			clock.Advance(30 * time.Second)
+			c.Set("b", 2)
+			c.Get("a")
+			clock.Advance(40 * time.Second)
+			c.Set("c", 3)
+
+			if !reflect.DeepEqual(evicted, []string{"a"}) {
+				t.Errorf("evicted = %v, want %v", evicted, []string{"a"})
+			}
+			if got := c.Get("b"); !reflect.DeepEqual(got, gutils.Some(2)) {
+				t.Errorf("Get() = %v, want %v", got, gutils.Some(2))
+			}
+			if got := c.Len(); got != 2 {
+				t.Errorf("Len() = %v, want %v", got, 2)
+			}
+		})
+	}
+}
+
+func TestCache_GetOrLoad(t *testing.T) {
+	c := New(Config[string, int]{})
+
+	var calls int32
+	release := make(chan struct{})
+	load := func(k string) gutils.Result[int] {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return gutils.Ok(len(k))
+	}
+
+	var wg sync.WaitGroup
+	results := make([]gutils.Result[int], 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = c.GetOrLoad("foo", load)
+		}(i)
+	}
+	for {
+		c.mu.Lock()
+		_, loading := c.loads["foo"]
+		c.mu.Unlock()
+		if loading {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("load called %v times, want 1", calls)
+	}
+	for _, r := range results {
+		if !reflect.DeepEqual(r, gutils.Ok(3)) {
+			t.Errorf("GetOrLoad() = %v, want %v", r, gutils.Ok(3))
+		}
+	}
+	if got := c.Get("foo"); !reflect.DeepEqual(got, gutils.Some(3)) {
+		t.Errorf("Get() = %v, want %v", got, gutils.Some(3))
+	}
+}
+
+func TestCache_GetOrLoadErr(t *testing.T) {
+	c := New(Config[string, int]{})
+	err := errors.New("error")
+
+	got := c.GetOrLoad("foo", func(k string) gutils.Result[int] { return gutils.Err[int](err) })
+	if !reflect.DeepEqual(got, gutils.Err[int](err)) {
+		t.Errorf("GetOrLoad() = %v, want %v", got, gutils.Err[int](err))
+	}
+	if got := c.Len(); got != 0 {
+		t.Errorf("Len() = %v, want %v", got, 0)
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var calls []int
+	f := Memoize(func(k int) int {
+		calls = append(calls, k)
+		return k * k
+	}, Config[int, int]{Capacity: 2, TTL: time.Minute, Clock: clock.Now})
+
+	for _, k := range []int{1, 2, 1, 3, 2, 1} {
+		if got := f(k); got != k*k {
+			t.Errorf("f(%v) = %v, want %v", k, got, k*k)
+		}
+	}
+	clock.Advance(time.Minute)
+	f(1)
+
+	if want := []int{1, 2, 3, 2, 1, 1}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestMemoizeR(t *testing.T) {
+	err := errors.New("error")
+	var calls int
+	f := MemoizeR(func(k string) gutils.Result[int] {
+		calls++
+		if calls == 1 {
+			return gutils.Err[int](err)
+		}
+		return gutils.Ok(len(k))
+	}, Config[string, int]{})
+
+	want := []gutils.Result[int]{gutils.Err[int](err), gutils.Ok(3), gutils.Ok(3)}
+	var got []gutils.Result[int]
+	for range want {
+		got = append(got, f("foo"))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("f() = %v, want %v", got, want)
+	}
+	if calls != 2 {
+		t.Errorf("f called %v times, want 2", calls)
+	}
+}
+
+func TestCache_GetOrLoadPanic(t *testing.T) {
+	c := New(Config[string, int]{})
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("GetOrLoad() did not panic")
+			}
+		}()
+		c.GetOrLoad("foo", func(k string) gutils.Result[int] { panic("boom") })
+	}()
+
+	if got := c.GetOrLoad("foo", func(k string) gutils.Result[int] { return gutils.Ok(1) }); !reflect.DeepEqual(got, gutils.Ok(1)) {
+		t.Errorf("GetOrLoad() = %v, want %v", got, gutils.Ok(1))
+	}
+}
@@ -0,0 +1,23 @@
+package cache
+
+import "github.com/Yuukirn/gutils"
+
+// Memoize caches the results of f in a Cache configured by cfg.
+// Concurrent calls with the same missing key call f once.
+func Memoize[K comparable, V any](f func(k K) V, cfg Config[K, V]) func(k K) V {
+	c := New(cfg)
+	return func(k K) V {
+		r := c.GetOrLoad(k, func(k K) gutils.Result[V] {
+			return gutils.Ok(f(k))
+		})
+		return r.Unwrap()
+	}
+}
+
+// MemoizeR is like Memoize but only caches Ok results, so an Err is retried on the next call.
+func MemoizeR[K comparable, V any](f func(k K) gutils.Result[V], cfg Config[K, V]) func(k K) gutils.Result[V] {
+	c := New(cfg)
+	return func(k K) gutils.Result[V] {
+		return c.GetOrLoad(k, f)
+	}
+}
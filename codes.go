@@ -0,0 +1,48 @@
+package gutils
+
+import "github.com/Yuukirn/gutils/errs"
+
+// Code returns the errs.Code of the error of r, or errs.OK if r is Ok.
+func (r *Result[T]) Code() errs.Code {
+	if r.IsOk() {
+		return errs.OK
+	}
+	return errs.CodeOf(r.err)
+}
+
+// ErrCode returns an Err holding an errs.Error with code and msg.
+func ErrCode[T any](code errs.Code, msg string) Result[T] {
+	return Err[T](errs.New(code, msg))
+}
+
+func ErrInvalidArgument[T any](msg string) Result[T] {
+	return ErrCode[T](errs.InvalidArgument, msg)
+}
+
+func ErrNotFound[T any](msg string) Result[T] {
+	return ErrCode[T](errs.NotFound, msg)
+}
+
+func ErrAlreadyExists[T any](msg string) Result[T] {
+	return ErrCode[T](errs.AlreadyExists, msg)
+}
+
+func ErrConflict[T any](msg string) Result[T] {
+	return ErrCode[T](errs.Conflict, msg)
+}
+
+func ErrPermissionDenied[T any](msg string) Result[T] {
+	return ErrCode[T](errs.PermissionDenied, msg)
+}
+
+func ErrUnauthenticated[T any](msg string) Result[T] {
+	return ErrCode[T](errs.Unauthenticated, msg)
+}
+
+func ErrUnavailable[T any](msg string) Result[T] {
+	return ErrCode[T](errs.Unavailable, msg)
+}
+
+func ErrInternal[T any](msg string) Result[T] {
+	return ErrCode[T](errs.Internal, msg)
+}
@@ -0,0 +1,203 @@
+package gutils
+
+// Tuple2 through Tuple5 hold the values combined by Zip2R through Zip5R.
+type Tuple2[A, B any] struct {
+	V1 A
+	V2 B
+}
+
+func (t Tuple2[A, B]) Unpack() (A, B) {
+	return t.V1, t.V2
+}
+
+type Tuple3[A, B, C any] struct {
+	V1 A
+	V2 B
+	V3 C
+}
+
+func (t Tuple3[A, B, C]) Unpack() (A, B, C) {
+	return t.V1, t.V2, t.V3
+}
+
+type Tuple4[A, B, C, D any] struct {
+	V1 A
+	V2 B
+	V3 C
+	V4 D
+}
+
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.V1, t.V2, t.V3, t.V4
+}
+
+type Tuple5[A, B, C, D, E any] struct {
+	V1 A
+	V2 B
+	V3 C
+	V4 D
+	V5 E
+}
+
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.V1, t.V2, t.V3, t.V4, t.V5
+}
+
+// Map2O calls f with the values of a and b if both are Some, and returns None otherwise.
+func Map2O[A, B, R any](a Option[A], b Option[B], f func(a A, b B) R) Option[R] {
+	if a.IsNone() || b.IsNone() {
+		return None[R]()
+	}
+	return Some(f(a.Some(), b.Some()))
+}
+
+func Map3O[A, B, C, R any](a Option[A], b Option[B], c Option[C], f func(a A, b B, c C) R) Option[R] {
+	if a.IsNone() || b.IsNone() || c.IsNone() {
+		return None[R]()
+	}
+	return Some(f(a.Some(), b.Some(), c.Some()))
+}
+
+func Map4O[A, B, C, D, R any](a Option[A], b Option[B], c Option[C], d Option[D], f func(a A, b B, c C, d D) R) Option[R] {
+	if a.IsNone() || b.IsNone() || c.IsNone() || d.IsNone() {
+		return None[R]()
+	}
+	return Some(f(a.Some(), b.Some(), c.Some(), d.Some()))
+}
+
+func Map5O[A, B, C, D, E, R any](a Option[A], b Option[B], c Option[C], d Option[D], e Option[E], f func(a A, b B, c C, d D, e E) R) Option[R] {
+	if a.IsNone() || b.IsNone() || c.IsNone() || d.IsNone() || e.IsNone() {
+		return None[R]()
+	}
+	return Some(f(a.Some(), b.Some(), c.Some(), d.Some(), e.Some()))
+}
+
+// Map2R calls f with the values of a and b if both are Ok, and returns the first error otherwise.
+// Use Zip2R to collect every error.
+func Map2R[A, B, R any](a Result[A], b Result[B], f func(a A, b B) R) Result[R] {
+	if a.IsErr() {
+		return Err[R](a.err)
+	}
+	if b.IsErr() {
+		return Err[R](b.err)
+	}
+	return Ok(f(a.ok, b.ok))
+}
+
+func Map3R[A, B, C, R any](a Result[A], b Result[B], c Result[C], f func(a A, b B, c C) R) Result[R] {
+	if a.IsErr() {
+		return Err[R](a.err)
+	}
+	if b.IsErr() {
+		return Err[R](b.err)
+	}
+	if c.IsErr() {
+		return Err[R](c.err)
+	}
+	return Ok(f(a.ok, b.ok, c.ok))
+}
+
+func Map4R[A, B, C, D, R any](a Result[A], b Result[B], c Result[C], d Result[D], f func(a A, b B, c C, d D) R) Result[R] {
+	if a.IsErr() {
+		return Err[R](a.err)
+	}
+	if b.IsErr() {
+		return Err[R](b.err)
+	}
+	if c.IsErr() {
+		return Err[R](c.err)
+	}
+	if d.IsErr() {
+		return Err[R](d.err)
+	}
+	return Ok(f(a.ok, b.ok, c.ok, d.ok))
+}
+
+func Map5R[A, B, C, D, E, R any](a Result[A], b Result[B], c Result[C], d Result[D], e Result[E], f func(a A, b B, c C, d D, e E) R) Result[R] {
+	if a.IsErr() {
+		return Err[R](a.err)
+	}
+	if b.IsErr() {
+		return Err[R](b.err)
+	}
+	if c.IsErr() {
+		return Err[R](c.err)
+	}
+	if d.IsErr() {
+		return Err[R](d.err)
+	}
+	if e.IsErr() {
+		return Err[R](e.err)
+	}
+	return Ok(f(a.ok, b.ok, c.ok, d.ok, e.ok))
+}
+
+// Zip2R returns the values of a and b if both are Ok, or a MultiError
+// with every error keyed by the position of its argument.
+func Zip2R[A, B any](a Result[A], b Result[B]) Result[Tuple2[A, B]] {
+	var me MultiError
+	me.Add(0, a.err)
+	me.Add(1, b.err)
+	if me.Len() > 0 {
+		return Err[Tuple2[A, B]](&me)
+	}
+	return Ok(Tuple2[A, B]{a.ok, b.ok})
+}
+
+func Zip3R[A, B, C any](a Result[A], b Result[B], c Result[C]) Result[Tuple3[A, B, C]] {
+	var me MultiError
+	me.Add(0, a.err)
+	me.Add(1, b.err)
+	me.Add(2, c.err)
+	if me.Len() > 0 {
+		return Err[Tuple3[A, B, C]](&me)
+	}
+	return Ok(Tuple3[A, B, C]{a.ok, b.ok, c.ok})
+}
+
+func Zip4R[A, B, C, D any](a Result[A], b Result[B], c Result[C], d Result[D]) Result[Tuple4[A, B, C, D]] {
+	var me MultiError
+	me.Add(0, a.err)
+	me.Add(1, b.err)
+	me.Add(2, c.err)
+	me.Add(3, d.err)
+	if me.Len() > 0 {
+		return Err[Tuple4[A, B, C, D]](&me)
+	}
+	return Ok(Tuple4[A, B, C, D]{a.ok, b.ok, c.ok, d.ok})
+}
+
+func Zip5R[A, B, C, D, E any](a Result[A], b Result[B], c Result[C], d Result[D], e Result[E]) Result[Tuple5[A, B, C, D, E]] {
+	var me MultiError
+	me.Add(0, a.err)
+	me.Add(1, b.err)
+	me.Add(2, c.err)
+	me.Add(3, d.err)
+	me.Add(4, e.err)
+	if me.Len() > 0 {
+		return Err[Tuple5[A, B, C, D, E]](&me)
+	}
+	return Ok(Tuple5[A, B, C, D, E]{a.ok, b.ok, c.ok, d.ok, e.ok})
+}
+
+// Lift turns f into a function on Options that maps Some values and passes None through.
+func Lift[A, B any](f func(a A) B) func(o Option[A]) Option[B] {
+	return func(o Option[A]) Option[B] {
+		return MapO(o, f)
+	}
+}
+
+// LiftR turns f into a function on Results that passes errors through
+// and wraps the error returned by f in an Err.
+func LiftR[A, B any](f func(a A) (B, error)) func(r Result[A]) Result[B] {
+	return func(r Result[A]) Result[B] {
+		if r.IsErr() {
+			return Err[B](r.err)
+		}
+		b, err := f(r.ok)
+		if err != nil {
+			return Err[B](err)
+		}
+		return Ok(b)
+	}
+}
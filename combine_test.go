@@ -0,0 +1,163 @@
+package gutils
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type server struct {
+	Host    string
+	Port    int
+	Timeout time.Duration
+}
+
+func TestMap3O(t *testing.T) {
+	type testCase struct {
+		name    string
+		host    Option[string]
+		port    Option[int]
+		timeout Option[time.Duration]
+		want    Option[server]
+	}
+	tests := []testCase{
+		{
+			name:    "Map3OTest1",
+			host:    Some("localhost"),
+			port:    Some(80),
+			timeout: Some(time.Second),
+			want:    Some(server{"localhost", 80, time.Second}),
+		},
+		{
+			name:    "Map3OTest2",
+			host:    Some("localhost"),
+			port:    None[int](),
+			timeout: Some(time.Second),
+			want:    None[server](),
+		},
+	}
+	mk := func(h string, p int, t time.Duration) server { return server{h, p, t} }
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Map3O(tt.host, tt.port, tt.timeout, mk); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Map3O() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapNO(t *testing.T) {
+	sum := func(ts ...int) int {
+		var res int
+		for _, t := range ts {
+			res += t
+		}
+		return res
+	}
+	one := Some(1)
+	got := []Option[int]{
+		Map2O(one, one, func(a, b int) int { return sum(a, b) }),
+		Map4O(one, one, one, one, func(a, b, c, d int) int { return sum(a, b, c, d) }),
+		Map5O(one, one, one, one, one, func(a, b, c, d, e int) int { return sum(a, b, c, d, e) }),
+		Map5O(one, one, one, one, None[int](), func(a, b, c, d, e int) int { return sum(a, b, c, d, e) }),
+	}
+	if want := []Option[int]{Some(2), Some(4), Some(5), None[int]()}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapNO() = %v, want %v", got, want)
+	}
+}
+
+func TestMap2R(t *testing.T) {
+	err1, err2 := errors.New("error1"), errors.New("error2")
+	type testCase struct {
+		name string
+		a    Result[string]
+		b    Result[int]
+		want Result[string]
+	}
+	tests := []testCase{
+		{name: "Map2RTest1", a: Ok("a"), b: Ok(1), want: Ok("a1")},
+		{name: "Map2RTest2", a: Err[string](err1), b: Err[int](err2), want: Err[string](err1)},
+		{name: "Map2RTest3", a: Ok("a"), b: Err[int](err2), want: Err[string](err2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Map2R(tt.a, tt.b, func(a string, b int) string { return a + strconv.Itoa(b) })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Map2R() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	got := Map5R(Ok(1), Ok(2), Ok(3), Ok(4), Err[int](err1), func(a, b, c, d, e int) int { return a + b + c + d + e })
+	if !reflect.DeepEqual(got, Err[int](err1)) {
+		t.Errorf("Map5R() = %v, want %v", got, Err[int](err1))
+	}
+}
+
+func TestZip3R(t *testing.T) {
+	err1, err2 := errors.New("error1"), errors.New("error2")
+
+	got := Zip3R(Ok("localhost"), Ok(80), Ok(time.Second))
+	if want := Ok(Tuple3[string, int, time.Duration]{"localhost", 80, time.Second}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Zip3R() = %v, want %v", got, want)
+	}
+	host, port, timeout := got.Unwrap().Unpack()
+	if host != "localhost" || port != 80 || timeout != time.Second {
+		t.Errorf("Unpack() = %v, %v, %v", host, port, timeout)
+	}
+
+	got = Zip3R(Err[string](err1), Ok(80), Err[time.Duration](err2))
+	want := []ErrorEntry{{Key: 0, Path: "[0]", Err: err1}, {Key: 2, Path: "[2]", Err: err2}}
+	if me := ErrAs[*MultiError](got); me.IsNone() || !reflect.DeepEqual(me.Some().Entries, want) {
+		t.Errorf("Zip3R() = %v, want entries %v", got, want)
+	}
+	if !errors.Is(got.ExpectErr(""), err2) {
+		t.Errorf("errors.Is() = false, want true")
+	}
+
+	if got := Zip5R(Ok(1), Ok("b"), Ok(true), Ok(2.5), Err[int](err1)); ErrAs[*MultiError](got).Some().Len() != 1 {
+		t.Errorf("Zip5R() = %v, want one error", got)
+	}
+}
+
+func TestLift(t *testing.T) {
+	double := Lift(func(a int) int { return a * 2 })
+	if got := double(Some(2)); !reflect.DeepEqual(got, Some(4)) {
+		t.Errorf("Lift() = %v, want %v", got, Some(4))
+	}
+	if got := double(None[int]()); !reflect.DeepEqual(got, None[int]()) {
+		t.Errorf("Lift() = %v, want %v", got, None[int]())
+	}
+}
+
+func TestLiftR(t *testing.T) {
+	err := errors.New("error")
+	atoi := LiftR(strconv.Atoi)
+	type testCase struct {
+		name    string
+		r       Result[string]
+		want    Result[int]
+		wantErr bool
+	}
+	tests := []testCase{
+		{name: "LiftRTest1", r: Ok("42"), want: Ok(42)},
+		{name: "LiftRTest2", r: Ok("x"), wantErr: true},
+		{name: "LiftRTest3", r: Err[string](err), want: Err[int](err)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := atoi(tt.r)
+			if tt.wantErr {
+				if !got.IsErr() {
+					t.Errorf("LiftR() = %v, want Err", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LiftR() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
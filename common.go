@@ -1,5 +1,7 @@
 package gutils
 
+import "reflect"
+
 func Zero[T any]() (t T) {
 	return
 }
@@ -18,3 +20,7 @@ func Deref[T any](t *T) T {
 	}
 	return *t
 }
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
@@ -0,0 +1,176 @@
+// Package config fills a struct from JSON files, environment variables and
+// command-line flags, in increasing order of precedence.
+//
+// Fields are configured with struct tags:
+//
+//	env:"NAME"       read from the environment variable NAME
+//	flag:"name"      read from the flag -name
+//	default:"value"  used when no source sets the field
+//	required:"true"  report an error when no default or source sets the field,
+//	                 even to its zero value
+//	secret:"true"    mask the value in Dump
+//
+// JSON files are decoded with encoding/json, so they follow the json tags.
+// gutils.Option fields stay None unless a source or a default sets them.
+// Nested structs without source tags of their own are filled field by field,
+// and their fields inherit the required and secret tags of the struct.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/Yuukirn/gutils"
+)
+
+var ErrRequired = errors.New("required")
+
+const mask = "******"
+
+type Sources struct {
+	// Files are JSON files decoded in order, so later files override earlier ones.
+	Files []string
+	// LookupEnv looks up environment variables; defaults to os.LookupEnv.
+	LookupEnv func(key string) (string, bool)
+	// Args are the command-line arguments without the program name.
+	// Flags are not parsed when Args is nil.
+	Args []string
+}
+
+// Load builds a T from src. Every failure is collected, keyed by field path
+// or file name, into a *gutils.MultiError.
+func Load[T any](src Sources) gutils.Result[T] {
+	var t T
+	v := reflect.ValueOf(&t).Elem()
+	if v.Kind() != reflect.Struct {
+		return gutils.Err[T](fmt.Errorf("config: %T is not a struct", t))
+	}
+	if src.LookupEnv == nil {
+		src.LookupEnv = os.LookupEnv
+	}
+
+	var me gutils.MultiError
+	fs := fields(v)
+	// loaded holds the paths of the fields a default or a source has set,
+	// which may be to their zero value.
+	var loaded = make(map[string]bool)
+	load := func(f field, source, s string) {
+		err := f.load(source, s)
+		me.Add(f.path, err)
+		if err == nil {
+			loaded[f.path] = true
+		}
+	}
+
+	for _, f := range fs {
+		if f.def.IsSome() {
+			load(f, "default", f.def.Some())
+		}
+	}
+	for _, file := range src.Files {
+		doc, err := loadFile(file, &t)
+		me.Add(file, err)
+		for _, f := range fs {
+			if err == nil && f.inJSON(doc) {
+				loaded[f.path] = true
+			}
+		}
+	}
+	for _, f := range fs {
+		if f.env == "" {
+			continue
+		}
+		if s, ok := src.LookupEnv(f.env); ok {
+			load(f, "env "+f.env, s)
+		}
+	}
+	if src.Args != nil {
+		parseFlags(fs, src.Args, &me, load)
+	}
+	for _, f := range fs {
+		if f.required && !loaded[f.path] {
+			me.Add(f.path, ErrRequired)
+		}
+	}
+
+	if err := me.ErrOrNil(); err != nil {
+		return gutils.Err[T](err)
+	}
+	return gutils.Ok(t)
+}
+
+// Dump lists the fields of the struct v, or of the struct it points to, one
+// "path = value" line each. The values of set secret fields are masked.
+func Dump(v any) string {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	var b strings.Builder
+	for _, f := range fields(rv) {
+		b.WriteString(f.path)
+		b.WriteString(" = ")
+		if f.secret && isSet(f.value) {
+			b.WriteString(mask)
+		} else {
+			b.WriteString(fmt.Sprint(f.value.Interface()))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// loadFile decodes the JSON file name into v, and also returns it as a generic
+// document to tell which keys it contains.
+func loadFile(name string, v any) (any, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	var doc any
+	return doc, json.Unmarshal(data, &doc)
+}
+
+// flagValue records the raw value of a flag so it can be parsed into its field afterwards.
+type flagValue struct {
+	isBool bool
+	raw    string
+}
+
+func (f *flagValue) String() string {
+	return f.raw
+}
+
+func (f *flagValue) Set(s string) error {
+	f.raw = s
+	return nil
+}
+
+func (f *flagValue) IsBoolFlag() bool {
+	return f.isBool
+}
+
+func parseFlags(fs []field, args []string, me *gutils.MultiError, load func(f field, source, s string)) {
+	flags := flag.NewFlagSet("config", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	var byName = make(map[string]field)
+	for _, f := range fs {
+		if f.flag != "" {
+			byName[f.flag] = f
+			flags.Var(&flagValue{isBool: isBool(f.value)}, f.flag, f.path)
+		}
+	}
+	if err := flags.Parse(args); err != nil {
+		me.Add("flags", err)
+		return
+	}
+	flags.Visit(func(fl *flag.Flag) {
+		load(byName[fl.Name], "flag -"+fl.Name, fl.Value.String())
+	})
+}
@@ -0,0 +1,216 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Yuukirn/gutils"
+)
+
+type database struct {
+	URL      string `json:"url" env:"DB_URL" required:"true"`
+	Password string `json:"password" env:"DB_PASSWORD" secret:"true"`
+}
+
+type settings struct {
+	Host     string                       `json:"host" env:"HOST" flag:"host" default:"localhost"`
+	Port     int                          `json:"port" env:"PORT" flag:"port" default:"8080"`
+	Debug    bool                         `json:"debug" flag:"debug"`
+	Timeout  time.Duration                `json:"timeout" env:"TIMEOUT" default:"5s"`
+	Tags     []string                     `json:"tags" env:"TAGS"`
+	Workers  gutils.Option[int]           `json:"workers" env:"WORKERS" flag:"workers"`
+	Region   gutils.Option[string]        `json:"region" env:"REGION"`
+	Deadline gutils.Option[time.Duration] `json:"-" flag:"deadline"`
+	Verbose  gutils.Option[bool]          `json:"-" flag:"v"`
+	Database database                     `json:"database"`
+	Token    gutils.Option[string]        `json:"-" env:"TOKEN" secret:"true"`
+	Limits   map[string]int               `json:"limits"`
+	Started  gutils.Option[time.Duration] `json:"-"`
+}
+
+func env(m map[string]string) func(string) (string, bool) {
+	return func(k string) (string, bool) {
+		v, ok := m[k]
+		return v, ok
+	}
+}
+
+func writeFile(t *testing.T, content string) string {
+	name := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(name, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return name
+}
+
+func TestLoad(t *testing.T) {
+	file := writeFile(t, `{"host":"file.example","port":9000,"tags":["a"],"database":{"url":"postgres://file","password":"p"},"limits":{"x":1}}`)
+
+	got := Load[settings](Sources{
+		Files:     []string{file},
+		LookupEnv: env(map[string]string{"PORT": "9001", "TAGS": "b, c", "WORKERS": "4", "TOKEN": "t0ken"}),
+		Args:      []string{"-port", "9002", "-debug", "-deadline=1m", "-v"},
+	})
+	want := settings{
+		Host:     "file.example",
+		Port:     9002,
+		Debug:    true,
+		Timeout:  5 * time.Second,
+		Tags:     []string{"b", "c"},
+		Workers:  gutils.Some(4),
+		Deadline: gutils.Some(time.Minute),
+		Verbose:  gutils.Some(true),
+		Database: database{URL: "postgres://file", Password: "p"},
+		Token:    gutils.Some("t0ken"),
+		Limits:   map[string]int{"x": 1},
+	}
+	if !reflect.DeepEqual(got, gutils.Ok(want)) {
+		t.Errorf("Load() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.json")
+	got := Load[settings](Sources{
+		Files:     []string{missing},
+		LookupEnv: env(map[string]string{"PORT": "http", "WORKERS": "many", "DB_PASSWORD": "x", "TIMEOUT": "soon"}),
+	})
+	me := gutils.ErrAs[*gutils.MultiError](got)
+	if me.IsNone() {
+		t.Fatalf("Load() = %v, want a MultiError", got)
+	}
+
+	var paths []string
+	for _, e := range me.Some().Entries {
+		paths = append(paths, e.Path)
+	}
+	want := []string{missing, "Port", "Timeout", "Workers", "Database.URL"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Load() paths = %v, want %v", paths, want)
+	}
+	if !errors.Is(got.ExpectErr(""), os.ErrNotExist) || !errors.Is(got.ExpectErr(""), ErrRequired) {
+		t.Errorf("Load() = %v, want os.ErrNotExist and ErrRequired", got)
+	}
+	if msg := me.Some().Entries[3].Err.Error(); msg != `env WORKERS: invalid value "many": strconv.ParseInt: parsing "many": invalid syntax` {
+		t.Errorf("Error() = %v", msg)
+	}
+}
+
+func TestLoad_SecretMasked(t *testing.T) {
+	type secrets struct {
+		PIN int `env:"PIN" secret:"true"`
+	}
+	got := Load[secrets](Sources{LookupEnv: env(map[string]string{"PIN": "12a4"})})
+	if msg := got.ExpectErr("").Error(); strings.Contains(msg, "12a4") {
+		t.Errorf("Error() = %v, leaks the secret", msg)
+	}
+}
+
+func TestLoad_Flags(t *testing.T) {
+	got := Load[settings](Sources{
+		LookupEnv: env(map[string]string{"DB_URL": "x"}),
+		Args:      []string{"-nope"},
+	})
+	if me := gutils.ErrAs[*gutils.MultiError](got); me.IsNone() || me.Some().Entries[0].Path != "flags" {
+		t.Errorf("Load() = %v, want a flags error", got)
+	}
+}
+
+func TestDump(t *testing.T) {
+	s := settings{
+		Host:     "localhost",
+		Port:     80,
+		Workers:  gutils.Some(2),
+		Database: database{URL: "postgres://x", Password: "hunter2"},
+		Token:    gutils.Some("t0ken"),
+	}
+	got := Dump(&s)
+	for _, want := range []string{"Host = localhost\n", "Workers = Some(2)\n", "Region = None\n", "Database.URL = postgres://x\n", "Database.Password = ******\n", "Token = ******\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Dump() = %v, want it to contain %q", got, want)
+		}
+	}
+	if strings.Contains(got, "hunter2") || strings.Contains(got, "t0ken") {
+		t.Errorf("Dump() = %v, leaks a secret", got)
+	}
+}
+
+func TestDump_SecretNested(t *testing.T) {
+	type creds struct {
+		User string
+		Pass string
+	}
+	type cfg struct {
+		Name  string
+		Creds creds `secret:"true"`
+	}
+	got := Dump(cfg{Name: "app", Creds: creds{"u", "hunter2"}})
+	if want := "Name = app\nCreds.User = ******\nCreds.Pass = ******\n"; got != want {
+		t.Errorf("Dump() = %q, want %q", got, want)
+	}
+}
+
+func TestLoad_RequiredNested(t *testing.T) {
+	type creds struct {
+		User string `env:"USER"`
+		Pass string `env:"PASS"`
+	}
+	type cfg struct {
+		Creds creds `required:"true"`
+	}
+	got := Load[cfg](Sources{LookupEnv: env(map[string]string{"USER": "u"})})
+	if want := "Creds.Pass: required"; !got.IsErr() || got.ExpectErr("").Error() != want {
+		t.Errorf("Load() = %v, want %v", got, want)
+	}
+}
+
+func TestLoad_RequiredZero(t *testing.T) {
+	type Common struct {
+		Debug bool `required:"true"`
+	}
+	type cfg struct {
+		Common
+		Port    int                `env:"PORT" required:"true"`
+		Retries int                `json:"retries" required:"true"`
+		Workers gutils.Option[int] `json:"workers" required:"true"`
+		Ignored int                `json:"-" required:"true"`
+	}
+	type testCase struct {
+		name string
+		env  map[string]string
+		file string
+		want []string
+	}
+	tests := []testCase{
+		{
+			name: "RequiredZeroTest1",
+			env:  map[string]string{"PORT": "0"},
+			file: `{"RETRIES":0,"workers":0,"debug":false}`,
+			want: []string{"Ignored"},
+		},
+		{
+			name: "RequiredZeroTest2",
+			file: `{"retries":null,"workers":null}`,
+			want: []string{"Common.Debug", "Port", "Retries", "Workers", "Ignored"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Load[cfg](Sources{Files: []string{writeFile(t, tt.file)}, LookupEnv: env(tt.env)})
+			var paths []string
+			if me := gutils.ErrAs[*gutils.MultiError](got); me.IsSome() {
+				for _, e := range me.Some().Entries {
+					paths = append(paths, e.Path)
+				}
+			}
+			if !reflect.DeepEqual(paths, tt.want) {
+				t.Errorf("Load() missing = %v, want %v", paths, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Yuukirn/gutils"
+)
+
+var (
+	optionPkg       = reflect.TypeOf(gutils.Option[int]{}).PkgPath()
+	durationType    = reflect.TypeOf(time.Duration(0))
+	unmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// field is a settable leaf of a configuration struct.
+type field struct {
+	path     string
+	value    reflect.Value
+	env      string
+	flag     string
+	def      gutils.Option[string]
+	required bool
+	secret   bool
+	// json is the key path of the field in JSON files, if it has one.
+	json gutils.Option[[]string]
+}
+
+// fields lists the leaves of the struct v, recursing into nested structs
+// that carry no source tags themselves.
+func fields(v reflect.Value) []field {
+	return nested(v, field{})
+}
+
+// nested lists the leaves of the struct v nested in parent. They inherit the
+// required and secret tags of parent.
+func nested(v reflect.Value, parent field) []field {
+	var res []field
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+		path := sf.Name
+		if parent.path != "" {
+			path = parent.path + "." + sf.Name
+		}
+
+		f := field{
+			path:     path,
+			value:    fv,
+			env:      sf.Tag.Get("env"),
+			flag:     sf.Tag.Get("flag"),
+			required: parent.required || sf.Tag.Get("required") == "true",
+			secret:   parent.secret || sf.Tag.Get("secret") == "true",
+			json:     jsonPath(parent, sf),
+		}
+		if d, ok := sf.Tag.Lookup("default"); ok {
+			f.def = gutils.Some(d)
+		}
+		if f.env == "" && f.flag == "" && f.def.IsNone() && isNested(fv.Type()) {
+			res = append(res, nested(fv, f)...)
+			continue
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+// jsonPath follows encoding/json: an untagged embedded struct adds no key,
+// and a field tagged "-" cannot be set from JSON.
+func jsonPath(parent field, sf reflect.StructField) gutils.Option[[]string] {
+	var prefix []string
+	if parent.path != "" {
+		if parent.json.IsNone() {
+			return gutils.None[[]string]()
+		}
+		prefix = parent.json.Some()
+	}
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	switch {
+	case name == "-":
+		return gutils.None[[]string]()
+	case name == "" && sf.Anonymous && sf.Type.Kind() == reflect.Struct:
+		return gutils.Some(prefix)
+	case name == "":
+		name = sf.Name
+	}
+	return gutils.Some(append(prefix[:len(prefix):len(prefix)], name))
+}
+
+// inJSON reports whether the document doc decoded from a JSON file holds a
+// non-null value for f. Keys match case-insensitively, as in encoding/json.
+func (f field) inJSON(doc any) bool {
+	if f.json.IsNone() {
+		return false
+	}
+	for _, key := range f.json.Some() {
+		m, ok := doc.(map[string]any)
+		if !ok {
+			return false
+		}
+		v, exist := m[key]
+		if !exist {
+			for k := range m {
+				if strings.EqualFold(k, key) {
+					v, exist = m[k], true
+					break
+				}
+			}
+		}
+		if !exist {
+			return false
+		}
+		doc = v
+	}
+	return doc != nil
+}
+
+func isNested(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !isOption(t) && !reflect.PointerTo(t).Implements(unmarshalerType)
+}
+
+func isOption(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == optionPkg && strings.HasPrefix(t.Name(), "Option[")
+}
+
+// isSet reports whether v holds a value: Some for an Option, non-zero otherwise.
+func isSet(v reflect.Value) bool {
+	if isOption(v.Type()) {
+		return v.MethodByName("IsSome").Call(nil)[0].Bool()
+	}
+	return !v.IsZero()
+}
+
+// isBool reports whether v is a bool or an Option of bool, which flags accept without a value.
+func isBool(v reflect.Value) bool {
+	t := v.Type()
+	if isOption(t) {
+		t = optionElem(t)
+	}
+	return t.Kind() == reflect.Bool
+}
+
+func optionElem(t reflect.Type) reflect.Type {
+	m, _ := reflect.PointerTo(t).MethodByName("Insert")
+	return m.Type.In(1)
+}
+
+// set parses s into v. Options are filled through their Insert method,
+// and slices are parsed from comma-separated lists.
+func set(v reflect.Value, s string) error {
+	t := v.Type()
+	if isOption(t) {
+		elem := reflect.New(optionElem(t)).Elem()
+		if err := set(elem, s); err != nil {
+			return err
+		}
+		v.Addr().MethodByName("Insert").Call([]reflect.Value{elem})
+		return nil
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	if t == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 0, t.Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 0, t.Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, t.Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Slice:
+		var parts []string
+		if s != "" {
+			parts = strings.Split(s, ",")
+		}
+		res := reflect.MakeSlice(t, len(parts), len(parts))
+		for i, p := range parts {
+			if err := set(res.Index(i), strings.TrimSpace(p)); err != nil {
+				return err
+			}
+		}
+		v.Set(res)
+	default:
+		return fmt.Errorf("unsupported type %v", t)
+	}
+	return nil
+}
+
+// load parses s from source into f, naming both in the error. Secret values are masked.
+func (f field) load(source, s string) error {
+	err := set(f.value, s)
+	if err == nil {
+		return nil
+	}
+	if f.secret {
+		// The parse error may quote the value too.
+		return fmt.Errorf("%s: invalid value %q", source, mask)
+	}
+	return fmt.Errorf("%s: invalid value %q: %w", source, s, err)
+}
@@ -0,0 +1,161 @@
+package container
+
+import (
+	"github.com/Yuukirn/gutils"
+	"github.com/Yuukirn/gutils/stream"
+)
+
+// Mode decides what a bounded container does when an element is pushed while it is full.
+type Mode int
+
+const (
+	// Reject drops the new element; the push reports false.
+	Reject Mode = iota
+	// Overwrite drops the element at the opposite end to make room.
+	Overwrite
+)
+
+const minCapacity = 8
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+// The zero value is an empty, unbounded Deque.
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	size  int
+	limit int
+	mode  Mode
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// NewBoundedDeque returns a Deque holding at most capacity elements.
+func NewBoundedDeque[T any](capacity int, mode Mode) *Deque[T] {
+	if capacity <= 0 {
+		panic("container: capacity must be positive")
+	}
+	return &Deque[T]{buf: make([]T, capacity), limit: capacity, mode: mode}
+}
+
+func (d *Deque[T]) PushBack(t T) bool {
+	if d.full() {
+		if d.mode == Reject {
+			return false
+		}
+		d.PopFront()
+	}
+	d.grow()
+	d.buf[d.index(d.size)] = t
+	d.size++
+	return true
+}
+
+func (d *Deque[T]) PushFront(t T) bool {
+	if d.full() {
+		if d.mode == Reject {
+			return false
+		}
+		d.PopBack()
+	}
+	d.grow()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = t
+	d.size++
+	return true
+}
+
+func (d *Deque[T]) PopFront() gutils.Option[T] {
+	if d.size == 0 {
+		return gutils.None[T]()
+	}
+	t := d.buf[d.head]
+	d.buf[d.head] = gutils.Zero[T]()
+	d.head = d.index(1)
+	d.size--
+	return gutils.Some(t)
+}
+
+func (d *Deque[T]) PopBack() gutils.Option[T] {
+	if d.size == 0 {
+		return gutils.None[T]()
+	}
+	i := d.index(d.size - 1)
+	t := d.buf[i]
+	d.buf[i] = gutils.Zero[T]()
+	d.size--
+	return gutils.Some(t)
+}
+
+func (d *Deque[T]) Front() gutils.Option[T] {
+	return d.At(0)
+}
+
+func (d *Deque[T]) Back() gutils.Option[T] {
+	return d.At(d.size - 1)
+}
+
+func (d *Deque[T]) At(i int) gutils.Option[T] {
+	if i < 0 || i >= d.size {
+		return gutils.None[T]()
+	}
+	return gutils.Some(d.buf[d.index(i)])
+}
+
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+func (d *Deque[T]) Clear() {
+	for i := 0; i < d.size; i++ {
+		d.buf[d.index(i)] = gutils.Zero[T]()
+	}
+	d.head = 0
+	d.size = 0
+}
+
+// Range calls f for every element from front to back until f returns false.
+func (d *Deque[T]) Range(f func(i int, t T) bool) {
+	for i := 0; i < d.size; i++ {
+		if !f(i, d.buf[d.index(i)]) {
+			return
+		}
+	}
+}
+
+func (d *Deque[T]) ToSlice() []T {
+	var res = make([]T, d.size)
+	n := copy(res, d.buf[d.head:min(d.head+d.size, len(d.buf))])
+	copy(res[n:], d.buf[:d.size-n])
+	return res
+}
+
+func (d *Deque[T]) ToSliceStream() *stream.SliceStream[T] {
+	return stream.NewSliceStream(d.ToSlice())
+}
+
+func (d *Deque[T]) full() bool {
+	return d.limit > 0 && d.size == d.limit
+}
+
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+	n := 2 * len(d.buf)
+	if n < minCapacity {
+		n = minCapacity
+	}
+	buf := d.ToSlice()
+	d.buf = append(buf, make([]T, n-len(buf))...)
+	d.head = 0
+}
@@ -0,0 +1,129 @@
+package container
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Yuukirn/gutils"
+)
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	if got := d.PopFront(); got.IsSome() {
+		t.Errorf("PopFront() = %v, want %v", got, gutils.None[int]())
+	}
+	if got := d.Back(); got.IsSome() {
+		t.Errorf("Back() = %v, want %v", got, gutils.None[int]())
+	}
+
+	for i := 0; i < 10; i++ {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	want := []int{-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if got := d.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+	if got := d.Front(); !reflect.DeepEqual(got, gutils.Some(-10)) {
+		t.Errorf("Front() = %v, want %v", got, gutils.Some(-10))
+	}
+	if got := d.Back(); !reflect.DeepEqual(got, gutils.Some(9)) {
+		t.Errorf("Back() = %v, want %v", got, gutils.Some(9))
+	}
+	if got := d.At(10); !reflect.DeepEqual(got, gutils.Some(0)) {
+		t.Errorf("At() = %v, want %v", got, gutils.Some(0))
+	}
+
+	var got []int
+	for o := d.PopBack(); o.IsSome(); o = d.PopBack() {
+		got = append(got, o.Unwrap())
+	}
+	if want := []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, -1, -2, -3, -4, -5, -6, -7, -8, -9, -10}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PopBack() = %v, want %v", got, want)
+	}
+	if !d.IsEmpty() {
+		t.Errorf("IsEmpty() = %v, want %v", d.IsEmpty(), true)
+	}
+}
+
+func TestDeque_Wrap(t *testing.T) {
+	d := NewDeque[int]()
+	for i := 0; i < 6; i++ {
+		d.PushBack(i)
+	}
+	for i := 0; i < 4; i++ {
+		d.PopFront()
+	}
+	for i := 6; i < 12; i++ {
+		d.PushBack(i)
+	}
+
+	want := []int{4, 5, 6, 7, 8, 9, 10, 11}
+	if got := d.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+	var got []int
+	d.Range(func(i int, t int) bool {
+		got = append(got, t)
+		return i < 2
+	})
+	if !reflect.DeepEqual(got, want[:3]) {
+		t.Errorf("Range() = %v, want %v", got, want[:3])
+	}
+	if got := d.ToSliceStream().ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSliceStream() = %v, want %v", got, want)
+	}
+
+	d.Clear()
+	if got := d.Len(); got != 0 {
+		t.Errorf("Len() = %v, want %v", got, 0)
+	}
+}
+
+func TestBoundedDeque(t *testing.T) {
+	type testCase struct {
+		name     string
+		mode     Mode
+		push     func(d *Deque[int], t int) bool
+		wantOk   []bool
+		wantList []int
+	}
+	tests := []testCase{
+		{
+			name:     "RejectBackTest",
+			mode:     Reject,
+			push:     (*Deque[int]).PushBack,
+			wantOk:   []bool{true, true, true, false, false},
+			wantList: []int{0, 1, 2},
+		},
+		{
+			name:     "OverwriteBackTest",
+			mode:     Overwrite,
+			push:     (*Deque[int]).PushBack,
+			wantOk:   []bool{true, true, true, true, true},
+			wantList: []int{2, 3, 4},
+		},
+		{
+			name:     "OverwriteFrontTest",
+			mode:     Overwrite,
+			push:     (*Deque[int]).PushFront,
+			wantOk:   []bool{true, true, true, true, true},
+			wantList: []int{4, 3, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewBoundedDeque[int](3, tt.mode)
+			var ok []bool
+			for i := 0; i < 5; i++ {
+				ok = append(ok, tt.push(d, i))
+			}
+			if !reflect.DeepEqual(ok, tt.wantOk) {
+				t.Errorf("push() = %v, want %v", ok, tt.wantOk)
+			}
+			if got := d.ToSlice(); !reflect.DeepEqual(got, tt.wantList) {
+				t.Errorf("ToSlice() = %v, want %v", got, tt.wantList)
+			}
+		})
+	}
+}
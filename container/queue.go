@@ -0,0 +1,111 @@
+package container
+
+import (
+	"github.com/Yuukirn/gutils"
+	"github.com/Yuukirn/gutils/stream"
+)
+
+// Queue is a first-in, first-out queue. The zero value is an empty, unbounded Queue.
+type Queue[T any] struct {
+	d Deque[T]
+}
+
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{}
+}
+
+// NewBoundedQueue returns a Queue holding at most capacity elements.
+// In Overwrite mode a push to a full queue drops the oldest element.
+func NewBoundedQueue[T any](capacity int, mode Mode) *Queue[T] {
+	return &Queue[T]{*NewBoundedDeque[T](capacity, mode)}
+}
+
+func (q *Queue[T]) Push(t T) bool {
+	return q.d.PushBack(t)
+}
+
+func (q *Queue[T]) Pop() gutils.Option[T] {
+	return q.d.PopFront()
+}
+
+func (q *Queue[T]) Peek() gutils.Option[T] {
+	return q.d.Front()
+}
+
+func (q *Queue[T]) Len() int {
+	return q.d.Len()
+}
+
+func (q *Queue[T]) IsEmpty() bool {
+	return q.d.IsEmpty()
+}
+
+func (q *Queue[T]) Clear() {
+	q.d.Clear()
+}
+
+// Range calls f for every element from oldest to newest until f returns false.
+func (q *Queue[T]) Range(f func(i int, t T) bool) {
+	q.d.Range(f)
+}
+
+func (q *Queue[T]) ToSlice() []T {
+	return q.d.ToSlice()
+}
+
+func (q *Queue[T]) ToSliceStream() *stream.SliceStream[T] {
+	return q.d.ToSliceStream()
+}
+
+// Stack is a last-in, first-out stack. The zero value is an empty, unbounded Stack.
+type Stack[T any] struct {
+	d Deque[T]
+}
+
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// NewBoundedStack returns a Stack holding at most capacity elements.
+// In Overwrite mode a push to a full stack drops the bottom element.
+func NewBoundedStack[T any](capacity int, mode Mode) *Stack[T] {
+	return &Stack[T]{*NewBoundedDeque[T](capacity, mode)}
+}
+
+func (s *Stack[T]) Push(t T) bool {
+	return s.d.PushBack(t)
+}
+
+func (s *Stack[T]) Pop() gutils.Option[T] {
+	return s.d.PopBack()
+}
+
+func (s *Stack[T]) Peek() gutils.Option[T] {
+	return s.d.Back()
+}
+
+func (s *Stack[T]) Len() int {
+	return s.d.Len()
+}
+
+func (s *Stack[T]) IsEmpty() bool {
+	return s.d.IsEmpty()
+}
+
+func (s *Stack[T]) Clear() {
+	s.d.Clear()
+}
+
+// Range calls f for every element from bottom to top until f returns false.
+func (s *Stack[T]) Range(f func(i int, t T) bool) {
+	s.d.Range(f)
+}
+
+// ToSlice returns the elements from bottom to top.
+func (s *Stack[T]) ToSlice() []T {
+	return s.d.ToSlice()
+}
+
+func (s *Stack[T]) ToSliceStream() *stream.SliceStream[T] {
+	return s.d.ToSliceStream()
+}
@@ -0,0 +1,65 @@
+package container
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Yuukirn/gutils"
+)
+
+func TestQueue(t *testing.T) {
+	var q Queue[string]
+	q.Push("a")
+	q.Push("b")
+	q.Push("c")
+
+	if got := q.Peek(); !reflect.DeepEqual(got, gutils.Some("a")) {
+		t.Errorf("Peek() = %v, want %v", got, gutils.Some("a"))
+	}
+	want := []gutils.Option[string]{gutils.Some("a"), gutils.Some("b"), gutils.Some("c"), gutils.None[string]()}
+	var got []gutils.Option[string]
+	for range want {
+		got = append(got, q.Pop())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() = %v, want %v", got, want)
+	}
+
+	bq := NewBoundedQueue[int](2, Overwrite)
+	bq.Push(1)
+	bq.Push(2)
+	bq.Push(3)
+	if got := bq.ToSlice(); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("ToSlice() = %v, want %v", got, []int{2, 3})
+	}
+}
+
+func TestStack(t *testing.T) {
+	s := NewStack[string]()
+	s.Push("a")
+	s.Push("b")
+	s.Push("c")
+
+	if got := s.Peek(); !reflect.DeepEqual(got, gutils.Some("c")) {
+		t.Errorf("Peek() = %v, want %v", got, gutils.Some("c"))
+	}
+	if got := s.ToSlice(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("ToSlice() = %v, want %v", got, []string{"a", "b", "c"})
+	}
+	want := []gutils.Option[string]{gutils.Some("c"), gutils.Some("b"), gutils.Some("a"), gutils.None[string]()}
+	var got []gutils.Option[string]
+	for range want {
+		got = append(got, s.Pop())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() = %v, want %v", got, want)
+	}
+
+	bs := NewBoundedStack[int](2, Reject)
+	if !bs.Push(1) || !bs.Push(2) || bs.Push(3) {
+		t.Errorf("Push() on full stack with Reject should fail")
+	}
+	if got := bs.Peek(); !reflect.DeepEqual(got, gutils.Some(2)) {
+		t.Errorf("Peek() = %v, want %v", got, gutils.Some(2))
+	}
+}
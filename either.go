@@ -0,0 +1,161 @@
+package gutils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNilLeft is the error of the Result EitherToResult returns for a Left nil error.
+var ErrNilLeft = errors.New("gutils: Left with a nil error")
+
+// Either holds a value of one of two types. The zero value is Left with the zero value of L.
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+func (e Either[L, R]) Left() Option[L] {
+	if e.IsRight() {
+		return None[L]()
+	}
+	return Some(e.left)
+}
+
+func (e Either[L, R]) Right() Option[R] {
+	if e.IsLeft() {
+		return None[R]()
+	}
+	return Some(e.right)
+}
+
+func (e Either[L, R]) Swap() Either[R, L] {
+	if e.IsLeft() {
+		return Right[R](e.left)
+	}
+	return Left[R, L](e.right)
+}
+
+func (e Either[L, R]) String() string {
+	if e.IsLeft() {
+		return fmt.Sprintf("Left(%v)", e.left)
+	}
+	return fmt.Sprintf("Right(%v)", e.right)
+}
+
+type eitherJSON struct {
+	Side  string          `json:"side"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON encodes e as {"side":"left"|"right","value":...}.
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	var (
+		res eitherJSON
+		err error
+	)
+	if e.IsLeft() {
+		res.Side = "left"
+		res.Value, err = json.Marshal(e.left)
+	} else {
+		res.Side = "right"
+		res.Value, err = json.Marshal(e.right)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	var v eitherJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	switch v.Side {
+	case "left":
+		var l L
+		if err := json.Unmarshal(v.Value, &l); err != nil {
+			return err
+		}
+		*e = Left[L, R](l)
+	case "right":
+		var r R
+		if err := json.Unmarshal(v.Value, &r); err != nil {
+			return err
+		}
+		*e = Right[L](r)
+	default:
+		return fmt.Errorf("gutils: invalid Either side %q", v.Side)
+	}
+	return nil
+}
+
+func Left[L, R any](l L) (e Either[L, R]) {
+	e.left = l
+	return
+}
+
+func Right[L, R any](r R) (e Either[L, R]) {
+	e.right = r
+	e.isRight = true
+	return
+}
+
+func Fold[L, R, U any](e Either[L, R], onLeft func(l L) U, onRight func(r R) U) U {
+	if e.IsLeft() {
+		return onLeft(e.left)
+	}
+	return onRight(e.right)
+}
+
+func MapLeft[L, R, U any](e Either[L, R], f func(l L) U) Either[U, R] {
+	if e.IsLeft() {
+		return Left[U, R](f(e.left))
+	}
+	return Right[U](e.right)
+}
+
+func MapRight[L, R, U any](e Either[L, R], f func(r R) U) Either[L, U] {
+	if e.IsLeft() {
+		return Left[L, U](e.left)
+	}
+	return Right[L](f(e.right))
+}
+
+// EitherFromOption returns Right with the value of o, or Left(l) if o is None.
+func EitherFromOption[L, R any](o Option[R], l L) Either[L, R] {
+	if o.IsNone() {
+		return Left[L, R](l)
+	}
+	return Right[L](o.Some())
+}
+
+func EitherFromResult[T any](r Result[T]) Either[error, T] {
+	if r.IsErr() {
+		return Left[error, T](r.err)
+	}
+	return Right[error](r.ok)
+}
+
+// EitherToResult returns Ok for Right and Err for Left. A Left nil error
+// becomes ErrNilLeft, so a Left never turns into Ok.
+func EitherToResult[T any](e Either[error, T]) Result[T] {
+	if e.IsLeft() {
+		if e.left == nil {
+			return Err[T](ErrNilLeft)
+		}
+		return Err[T](e.left)
+	}
+	return Ok(e.right)
+}
@@ -0,0 +1,184 @@
+package gutils
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestEither_IsLeft(t *testing.T) {
+	type testCase[L, R any] struct {
+		name      string
+		e         Either[L, R]
+		wantLeft  Option[L]
+		wantRight Option[R]
+	}
+	tests := []testCase[string, int]{
+		{
+			name:      "Either_IsLeftTest1",
+			e:         Left[string, int]("foo"),
+			wantLeft:  Some("foo"),
+			wantRight: None[int](),
+		},
+		{
+			name:      "Either_IsLeftTest2",
+			e:         Right[string](2),
+			wantLeft:  None[string](),
+			wantRight: Some(2),
+		},
+		{
+			name:      "Either_IsLeftTest3",
+			e:         Either[string, int]{},
+			wantLeft:  Some(""),
+			wantRight: None[int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.IsLeft(); got != tt.wantLeft.IsSome() {
+				t.Errorf("IsLeft() = %v, want %v", got, tt.wantLeft.IsSome())
+			}
+			if got := tt.e.IsRight(); got != tt.wantRight.IsSome() {
+				t.Errorf("IsRight() = %v, want %v", got, tt.wantRight.IsSome())
+			}
+			if got := tt.e.Left(); !reflect.DeepEqual(got, tt.wantLeft) {
+				t.Errorf("Left() = %v, want %v", got, tt.wantLeft)
+			}
+			if got := tt.e.Right(); !reflect.DeepEqual(got, tt.wantRight) {
+				t.Errorf("Right() = %v, want %v", got, tt.wantRight)
+			}
+		})
+	}
+}
+
+func TestEither_Swap(t *testing.T) {
+	if got := Left[string, int]("foo").Swap(); !reflect.DeepEqual(got, Right[int]("foo")) {
+		t.Errorf("Swap() = %v, want %v", got, Right[int]("foo"))
+	}
+	if got := Right[string](2).Swap(); !reflect.DeepEqual(got, Left[int, string](2)) {
+		t.Errorf("Swap() = %v, want %v", got, Left[int, string](2))
+	}
+}
+
+func TestFold(t *testing.T) {
+	type testCase[L, R, U any] struct {
+		name string
+		e    Either[L, R]
+		want U
+	}
+	tests := []testCase[string, int, string]{
+		{
+			name: "FoldTest1",
+			e:    Left[string, int]("foo"),
+			want: "left foo",
+		},
+		{
+			name: "FoldTest2",
+			e:    Right[string](2),
+			want: "right 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Fold(tt.e,
+				func(l string) string { return "left " + l },
+				func(r int) string { return "right " + strconv.Itoa(r) })
+			if got != tt.want {
+				t.Errorf("Fold() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapLeft(t *testing.T) {
+	if got := MapLeft(Left[string, int]("foo"), func(l string) int { return len(l) }); !reflect.DeepEqual(got, Left[int, int](3)) {
+		t.Errorf("MapLeft() = %v, want %v", got, Left[int, int](3))
+	}
+	if got := MapLeft(Right[string](2), func(l string) int { return len(l) }); !reflect.DeepEqual(got, Right[int](2)) {
+		t.Errorf("MapLeft() = %v, want %v", got, Right[int](2))
+	}
+}
+
+func TestMapRight(t *testing.T) {
+	if got := MapRight(Right[string](2), strconv.Itoa); !reflect.DeepEqual(got, Right[string]("2")) {
+		t.Errorf("MapRight() = %v, want %v", got, Right[string]("2"))
+	}
+	if got := MapRight(Left[string, int]("foo"), strconv.Itoa); !reflect.DeepEqual(got, Left[string, string]("foo")) {
+		t.Errorf("MapRight() = %v, want %v", got, Left[string, string]("foo"))
+	}
+}
+
+func TestEitherConversions(t *testing.T) {
+	err := errors.New("error")
+
+	if got := EitherFromOption(Some(2), "none"); !reflect.DeepEqual(got, Right[string](2)) {
+		t.Errorf("EitherFromOption() = %v, want %v", got, Right[string](2))
+	}
+	if got := EitherFromOption(None[int](), "none"); !reflect.DeepEqual(got, Left[string, int]("none")) {
+		t.Errorf("EitherFromOption() = %v, want %v", got, Left[string, int]("none"))
+	}
+	if got := EitherFromResult(Ok(2)); !reflect.DeepEqual(got, Right[error](2)) {
+		t.Errorf("EitherFromResult() = %v, want %v", got, Right[error](2))
+	}
+	if got := EitherFromResult(Err[int](err)); !reflect.DeepEqual(got, Left[error, int](err)) {
+		t.Errorf("EitherFromResult() = %v, want %v", got, Left[error, int](err))
+	}
+	if got := EitherToResult(Right[error](2)); !reflect.DeepEqual(got, Ok(2)) {
+		t.Errorf("EitherToResult() = %v, want %v", got, Ok(2))
+	}
+	if got := EitherToResult(Left[error, int](err)); !reflect.DeepEqual(got, Err[int](err)) {
+		t.Errorf("EitherToResult() = %v, want %v", got, Err[int](err))
+	}
+	if got := EitherToResult(Left[error, int](nil)); !got.IsErrIs(ErrNilLeft) {
+		t.Errorf("EitherToResult() = %v, want %v", got, Err[int](ErrNilLeft))
+	}
+}
+
+func TestEither_JSON(t *testing.T) {
+	type testCase[L, R any] struct {
+		name string
+		e    Either[L, R]
+		data string
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "Either_JSONTest1",
+			e:    Left[string, int]("foo"),
+			data: `{"side":"left","value":"foo"}`,
+		},
+		{
+			name: "Either_JSONTest2",
+			e:    Right[string](2),
+			data: `{"side":"right","value":2}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.e)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(data) != tt.data {
+				t.Errorf("Marshal() = %s, want %s", data, tt.data)
+			}
+
+			var got Either[string, int]
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.e) {
+				t.Errorf("Unmarshal() = %v, want %v", got, tt.e)
+			}
+		})
+	}
+
+	var e Either[string, int]
+	if err := json.Unmarshal([]byte(`{"side":"middle","value":2}`), &e); err == nil {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, true)
+	}
+	if err := json.Unmarshal([]byte(`{"side":"right","value":"foo"}`), &e); err == nil {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, true)
+	}
+}
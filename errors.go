@@ -0,0 +1,125 @@
+package gutils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+)
+
+// WrapError adds a message and the call site that added it to an error.
+type WrapError struct {
+	Msg  string
+	File string
+	Line int
+	Err  error
+	// MsgErr is the error Msg was formatted from when it wraps other errors
+	// through %w, as with Result.Wrapf. It is nil otherwise.
+	MsgErr error
+}
+
+func (e *WrapError) Error() string {
+	return e.Msg + ": " + e.Err.Error()
+}
+
+// Unwrap returns Err, and MsgErr if it is set, so errors.Is and errors.As
+// match the errors wrapped by either.
+func (e *WrapError) Unwrap() []error {
+	if e.MsgErr == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Err, e.MsgErr}
+}
+
+func (e *WrapError) Location() string {
+	return filepath.Base(e.File) + ":" + strconv.Itoa(e.Line)
+}
+
+// Format prints the call site of every wrap in the chain with %+v.
+func (e *WrapError) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('+') {
+		_, _ = fmt.Fprintf(f, "%s (%s): %+v", e.Msg, e.Location(), e.Err)
+		return
+	}
+	_, _ = io.WriteString(f, e.Error())
+}
+
+// FieldError attaches a key-value pair to an error.
+type FieldError struct {
+	Key   string
+	Value any
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s [%s=%v]", e.Err, e.Key, e.Value)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Fields collects the key-value pairs attached to err and the errors it wraps.
+// Outer values take precedence over inner ones with the same key.
+func Fields(err error) map[string]any {
+	var res = make(map[string]any)
+	collectFields(err, res)
+	return res
+}
+
+func collectFields(err error, res map[string]any) {
+	if err == nil {
+		return
+	}
+	if fe, ok := err.(*FieldError); ok {
+		if _, exist := res[fe.Key]; !exist {
+			res[fe.Key] = fe.Value
+		}
+	}
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		collectFields(e.Unwrap(), res)
+	case interface{ Unwrap() []error }:
+		for _, err := range e.Unwrap() {
+			collectFields(err, res)
+		}
+	}
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ErrAs returns the first error in the chain of r that errors.As can assign to E.
+// It returns None if r is Ok, and also if E is neither an interface nor an
+// error type, where errors.As would panic.
+func ErrAs[E, T any](r Result[T]) Option[E] {
+	var e E
+	t := reflect.TypeOf(&e).Elem()
+	if t.Kind() != reflect.Interface && !t.Implements(errorType) {
+		return None[E]()
+	}
+	if r.IsErr() && errors.As(r.err, &e) {
+		return Some(e)
+	}
+	return None[E]()
+}
+
+func wrap(err error, msg string) error {
+	_, file, line, _ := runtime.Caller(2)
+	return &WrapError{Msg: msg, File: file, Line: line, Err: err}
+}
+
+// wrapf is wrap with a message formatted by fmt.Errorf. The formatted error is
+// kept as MsgErr if it wraps errors through %w.
+func wrapf(err error, format string, args ...any) error {
+	_, file, line, _ := runtime.Caller(2)
+	msg := fmt.Errorf(format, args...)
+	we := &WrapError{Msg: msg.Error(), File: file, Line: line, Err: err}
+	switch msg.(type) {
+	case interface{ Unwrap() error }, interface{ Unwrap() []error }:
+		we.MsgErr = msg
+	}
+	return we
+}
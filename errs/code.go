@@ -0,0 +1,71 @@
+package errs
+
+import "net/http"
+
+// Code classifies an error independently of the transport that reports it.
+// The standard codes follow the gRPC status codes.
+type Code string
+
+const (
+	OK                 Code = "ok"
+	Canceled           Code = "canceled"
+	Unknown            Code = "unknown"
+	InvalidArgument    Code = "invalid_argument"
+	DeadlineExceeded   Code = "deadline_exceeded"
+	NotFound           Code = "not_found"
+	AlreadyExists      Code = "already_exists"
+	PermissionDenied   Code = "permission_denied"
+	ResourceExhausted  Code = "resource_exhausted"
+	FailedPrecondition Code = "failed_precondition"
+	// Conflict reports a concurrent modification, such as a failed compare-and-swap.
+	Conflict        Code = "conflict"
+	OutOfRange      Code = "out_of_range"
+	Unimplemented   Code = "unimplemented"
+	Internal        Code = "internal"
+	Unavailable     Code = "unavailable"
+	DataLoss        Code = "data_loss"
+	Unauthenticated Code = "unauthenticated"
+)
+
+type mapping struct {
+	http int
+	grpc uint32
+}
+
+var mappings = map[Code]mapping{
+	OK:                 {http.StatusOK, 0},
+	Canceled:           {499, 1},
+	Unknown:            {http.StatusInternalServerError, 2},
+	InvalidArgument:    {http.StatusBadRequest, 3},
+	DeadlineExceeded:   {http.StatusGatewayTimeout, 4},
+	NotFound:           {http.StatusNotFound, 5},
+	AlreadyExists:      {http.StatusConflict, 6},
+	PermissionDenied:   {http.StatusForbidden, 7},
+	ResourceExhausted:  {http.StatusTooManyRequests, 8},
+	FailedPrecondition: {http.StatusBadRequest, 9},
+	Conflict:           {http.StatusConflict, 10},
+	OutOfRange:         {http.StatusBadRequest, 11},
+	Unimplemented:      {http.StatusNotImplemented, 12},
+	Internal:           {http.StatusInternalServerError, 13},
+	Unavailable:        {http.StatusServiceUnavailable, 14},
+	DataLoss:           {http.StatusInternalServerError, 15},
+	Unauthenticated:    {http.StatusUnauthorized, 16},
+}
+
+// HTTPStatus returns the HTTP status code for c. Unknown codes map to 500.
+func (c Code) HTTPStatus() int {
+	return c.mapping().http
+}
+
+// GRPCCode returns the numeric gRPC status code for c, which converts
+// directly to codes.Code. Conflict maps to Aborted.
+func (c Code) GRPCCode() uint32 {
+	return c.mapping().grpc
+}
+
+func (c Code) mapping() mapping {
+	if m, exist := mappings[c]; exist {
+		return m
+	}
+	return mappings[Unknown]
+}
@@ -0,0 +1,89 @@
+package errs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Error is an error with a Code, a message safe to show to clients,
+// optional details and an optional cause that is kept private.
+type Error struct {
+	Code    Code
+	Message string
+	Details map[string]any
+	Cause   error
+}
+
+func New(code Code, msg string) *Error {
+	return &Error{Code: code, Message: msg}
+}
+
+func Newf(code Code, format string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap returns an Error with code and msg caused by err.
+func Wrap(err error, code Code, msg string) *Error {
+	return &Error{Code: code, Message: msg, Cause: err}
+}
+
+func (e *Error) Error() string {
+	var res = string(e.Code)
+	if e.Message != "" {
+		res += ": " + e.Message
+	}
+	if e.Cause != nil {
+		res += ": " + e.Cause.Error()
+	}
+	return res
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// WithDetail returns a copy of e with the detail k set to v.
+func (e *Error) WithDetail(k string, v any) *Error {
+	var res = *e
+	res.Details = make(map[string]any, len(e.Details)+1)
+	for dk, dv := range e.Details {
+		res.Details[dk] = dv
+	}
+	res.Details[k] = v
+	return &res
+}
+
+// MarshalJSON encodes the code, message and details of e. The cause is left out.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code    Code           `json:"code"`
+		Message string         `json:"message,omitempty"`
+		Details map[string]any `json:"details,omitempty"`
+	}{e.Code, e.Message, e.Details})
+}
+
+// CodeOf returns the Code of the first Error in the chain of err.
+// Context cancellation and deadline errors get their own codes, nil is OK
+// and anything else is Unknown.
+func CodeOf(err error) Code {
+	if err == nil {
+		return OK
+	}
+	var e *Error
+	switch {
+	case errors.As(err, &e):
+		return e.Code
+	case errors.Is(err, context.Canceled):
+		return Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return DeadlineExceeded
+	}
+	return Unknown
+}
+
+// Is reports whether the Code of err is code.
+func Is(err error, code Code) bool {
+	return CodeOf(err) == code
+}
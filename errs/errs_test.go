@@ -0,0 +1,87 @@
+package errs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestCodeOf(t *testing.T) {
+	type testCase struct {
+		name string
+		err  error
+		want Code
+	}
+	tests := []testCase{
+		{name: "CodeOfTest1", err: nil, want: OK},
+		{name: "CodeOfTest2", err: New(NotFound, "user 1"), want: NotFound},
+		{name: "CodeOfTest3", err: fmt.Errorf("load: %w", Wrap(io.EOF, Unavailable, "db")), want: Unavailable},
+		{name: "CodeOfTest4", err: fmt.Errorf("call: %w", context.DeadlineExceeded), want: DeadlineExceeded},
+		{name: "CodeOfTest5", err: context.Canceled, want: Canceled},
+		{name: "CodeOfTest6", err: io.EOF, want: Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CodeOf(tt.err); got != tt.want {
+				t.Errorf("CodeOf() = %v, want %v", got, tt.want)
+			}
+			if !Is(tt.err, tt.want) {
+				t.Errorf("Is() = false, want true")
+			}
+		})
+	}
+}
+
+func TestCode_Mapping(t *testing.T) {
+	type testCase struct {
+		name string
+		code Code
+		http int
+		grpc uint32
+	}
+	tests := []testCase{
+		{name: "MappingTest1", code: OK, http: 200, grpc: 0},
+		{name: "MappingTest2", code: NotFound, http: 404, grpc: 5},
+		{name: "MappingTest3", code: InvalidArgument, http: 400, grpc: 3},
+		{name: "MappingTest4", code: Conflict, http: 409, grpc: 10},
+		{name: "MappingTest5", code: Unavailable, http: 503, grpc: 14},
+		{name: "MappingTest6", code: Unauthenticated, http: 401, grpc: 16},
+		{name: "MappingTest7", code: Code("bogus"), http: 500, grpc: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.code.HTTPStatus(); got != tt.http {
+				t.Errorf("HTTPStatus() = %v, want %v", got, tt.http)
+			}
+			if got := tt.code.GRPCCode(); got != tt.grpc {
+				t.Errorf("GRPCCode() = %v, want %v", got, tt.grpc)
+			}
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	base := Wrap(io.EOF, Unavailable, "db is down")
+	e := base.WithDetail("retry_after", 3)
+
+	if got := e.Error(); got != "unavailable: db is down: EOF" {
+		t.Errorf("Error() = %v, want %v", got, "unavailable: db is down: EOF")
+	}
+	if !errors.Is(e, io.EOF) {
+		t.Errorf("errors.Is() = false, want true")
+	}
+	if base.Details != nil {
+		t.Errorf("WithDetail() modified the receiver: %v", base.Details)
+	}
+
+	got, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"code":"unavailable","message":"db is down","details":{"retry_after":3}}`; string(got) != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+}
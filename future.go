@@ -0,0 +1,187 @@
+package gutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var errNoFutures = errors.New("gutils: no futures to wait for")
+
+// Future is the eventual Result of a function running in its own goroutine.
+type Future[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	res    Result[T]
+}
+
+// Async runs f in a new goroutine with a context derived from ctx.
+// The context is canceled when f returns, when ctx is canceled or when Cancel is called.
+// A panic in f is recovered and reported as an Err.
+func Async[T any](ctx context.Context, f func(ctx context.Context) (T, error)) *Future[T] {
+	fctx, cancel := context.WithCancel(ctx)
+	fut := &Future[T]{ctx: ctx, cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(fut.done)
+		defer cancel()
+		defer func() {
+			if p := recover(); p != nil {
+				fut.res = Err[T](fmt.Errorf("gutils: future panicked: %v", p))
+			}
+		}()
+
+		t, err := f(fctx)
+		if err != nil {
+			fut.res = Err[T](err)
+			return
+		}
+		fut.res = Ok(t)
+	}()
+	return fut
+}
+
+func (f *Future[T]) Await() Result[T] {
+	<-f.done
+	return f.res
+}
+
+// AwaitTimeout waits at most d for the result and returns an Err wrapping
+// context.DeadlineExceeded if it is not ready. The future keeps running.
+func (f *Future[T]) AwaitTimeout(d time.Duration) Result[T] {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-f.done:
+		return f.res
+	case <-timer.C:
+		return Err[T](fmt.Errorf("gutils: future not ready after %v: %w", d, context.DeadlineExceeded))
+	}
+}
+
+func (f *Future[T]) Cancel() {
+	f.cancel()
+}
+
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+func (f *Future[T]) await(ctx context.Context) Result[T] {
+	select {
+	case <-f.done:
+		return f.res
+	case <-ctx.Done():
+		return Err[T](ctx.Err())
+	}
+}
+
+// Then runs fn with the value of f once it is Ok, under the same parent context as f.
+func Then[T, U any](f *Future[T], fn func(ctx context.Context, t T) (U, error)) *Future[U] {
+	return Async(f.ctx, func(ctx context.Context) (U, error) {
+		r := f.await(ctx)
+		if r.IsErr() {
+			return Zero[U](), r.err
+		}
+		return fn(ctx, r.ok)
+	})
+}
+
+func ThenR[T, U any](f *Future[T], fn func(ctx context.Context, t T) Result[U]) *Future[U] {
+	return Then(f, func(ctx context.Context, t T) (U, error) {
+		r := fn(ctx, t)
+		return r.ok, r.err
+	})
+}
+
+// All waits for every future and returns their values in order.
+// On the first Err, the remaining futures are canceled and that error is returned.
+func All[T any](fs ...*Future[T]) Result[[]T] {
+	ch := settle(fs)
+	var res = make([]T, len(fs))
+	for range fs {
+		s := <-ch
+		if s.res.IsErr() {
+			cancelAll(fs)
+			return Err[[]T](s.res.err)
+		}
+		res[s.i] = s.res.ok
+	}
+	return Ok(res)
+}
+
+// Any returns the first Ok result and cancels the remaining futures.
+// If every future fails, the errors are joined.
+func Any[T any](fs ...*Future[T]) Result[T] {
+	if len(fs) == 0 {
+		return Err[T](errNoFutures)
+	}
+
+	ch := settle(fs)
+	var errs = make([]error, len(fs))
+	for range fs {
+		s := <-ch
+		if s.res.IsOk() {
+			cancelAll(fs)
+			return s.res
+		}
+		errs[s.i] = s.res.err
+	}
+	return Err[T](errors.Join(errs...))
+}
+
+// Race returns the first Ok result and cancels the remaining futures, like Any.
+// Unlike Promise.race in JavaScript, a future that fails first does not end the race.
+// If every future fails, Race returns the first error to settle, where Any joins them all.
+func Race[T any](fs ...*Future[T]) Result[T] {
+	if len(fs) == 0 {
+		return Err[T](errNoFutures)
+	}
+
+	ch := settle(fs)
+	var first Result[T]
+	for i := range fs {
+		s := <-ch
+		if s.res.IsOk() {
+			cancelAll(fs)
+			return s.res
+		}
+		if i == 0 {
+			first = s.res
+		}
+	}
+	return first
+}
+
+func AllSettled[T any](fs ...*Future[T]) []Result[T] {
+	var res = make([]Result[T], 0, len(fs))
+	for _, f := range fs {
+		res = append(res, f.Await())
+	}
+	return res
+}
+
+type settled[T any] struct {
+	i   int
+	res Result[T]
+}
+
+// settle reports each future on the returned channel as it completes.
+// The channel is buffered so the waiting goroutines never block.
+func settle[T any](fs []*Future[T]) <-chan settled[T] {
+	ch := make(chan settled[T], len(fs))
+	for i, f := range fs {
+		go func(i int, f *Future[T]) {
+			ch <- settled[T]{i, f.Await()}
+		}(i, f)
+	}
+	return ch
+}
+
+func cancelAll[T any](fs []*Future[T]) {
+	for _, f := range fs {
+		f.Cancel()
+	}
+}
@@ -0,0 +1,190 @@
+package gutils
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func checkNoLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before {
+			if time.Now().After(deadline) {
+				t.Errorf("goroutines = %v, want %v", runtime.NumGoroutine(), before)
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+}
+
+func value[T any](t T, d time.Duration) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		select {
+		case <-time.After(d):
+			return t, nil
+		case <-ctx.Done():
+			return Zero[T](), ctx.Err()
+		}
+	}
+}
+
+func failure[T any](err error, d time.Duration) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		select {
+		case <-time.After(d):
+			return Zero[T](), err
+		case <-ctx.Done():
+			return Zero[T](), ctx.Err()
+		}
+	}
+}
+
+func TestFuture_Await(t *testing.T) {
+	checkNoLeak(t)
+	err := errors.New("error")
+
+	if got := Async(context.Background(), value(2, 0)).Await(); !reflect.DeepEqual(got, Ok(2)) {
+		t.Errorf("Await() = %v, want %v", got, Ok(2))
+	}
+	if got := Async(context.Background(), failure[int](err, 0)).Await(); !reflect.DeepEqual(got, Err[int](err)) {
+		t.Errorf("Await() = %v, want %v", got, Err[int](err))
+	}
+
+	got := Async(context.Background(), func(ctx context.Context) (int, error) { panic("boom") }).Await()
+	if !got.IsErr() {
+		t.Errorf("Await() = %v, want Err", got)
+	}
+}
+
+func TestFuture_AwaitTimeout(t *testing.T) {
+	checkNoLeak(t)
+
+	f := Async(context.Background(), value(2, time.Hour))
+	if got := f.AwaitTimeout(time.Millisecond); !got.IsErrIs(context.DeadlineExceeded) {
+		t.Errorf("AwaitTimeout() = %v, want %v", got, context.DeadlineExceeded)
+	}
+	f.Cancel()
+	if got := f.Await(); !got.IsErrIs(context.Canceled) {
+		t.Errorf("Await() = %v, want %v", got, context.Canceled)
+	}
+
+	f = Async(context.Background(), value(2, 0))
+	if got := f.AwaitTimeout(time.Second); !reflect.DeepEqual(got, Ok(2)) {
+		t.Errorf("AwaitTimeout() = %v, want %v", got, Ok(2))
+	}
+}
+
+func TestFuture_Cancel(t *testing.T) {
+	checkNoLeak(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f := Async(ctx, value(2, time.Hour))
+	g := Then(f, func(ctx context.Context, t int) (int, error) { return t + 1, nil })
+	cancel()
+
+	if got := f.Await(); !got.IsErrIs(context.Canceled) {
+		t.Errorf("Await() = %v, want %v", got, context.Canceled)
+	}
+	if got := g.Await(); !got.IsErrIs(context.Canceled) {
+		t.Errorf("Await() = %v, want %v", got, context.Canceled)
+	}
+}
+
+func TestThen(t *testing.T) {
+	checkNoLeak(t)
+	err := errors.New("error")
+
+	f := Async(context.Background(), value(2, time.Millisecond))
+	g := Then(f, func(ctx context.Context, t int) (string, error) { return "foo", nil })
+	h := ThenR(g, func(ctx context.Context, s string) Result[int] { return Ok(len(s)) })
+	if got := h.Await(); !reflect.DeepEqual(got, Ok(3)) {
+		t.Errorf("Await() = %v, want %v", got, Ok(3))
+	}
+
+	f = Async(context.Background(), failure[int](err, time.Millisecond))
+	g = Then(f, func(ctx context.Context, t int) (string, error) { return "foo", nil })
+	if got := g.Await(); !reflect.DeepEqual(got, Err[string](err)) {
+		t.Errorf("Await() = %v, want %v", got, Err[string](err))
+	}
+}
+
+func TestAll(t *testing.T) {
+	checkNoLeak(t)
+	err := errors.New("error")
+	ctx := context.Background()
+
+	got := All(Async(ctx, value(1, 2*time.Millisecond)), Async(ctx, value(2, 0)), Async(ctx, value(3, time.Millisecond)))
+	if !reflect.DeepEqual(got, Ok([]int{1, 2, 3})) {
+		t.Errorf("All() = %v, want %v", got, Ok([]int{1, 2, 3}))
+	}
+
+	slow := Async(ctx, value(1, time.Hour))
+	got = All(slow, Async(ctx, failure[int](err, time.Millisecond)))
+	if !reflect.DeepEqual(got, Err[[]int](err)) {
+		t.Errorf("All() = %v, want %v", got, Err[[]int](err))
+	}
+	if r := slow.Await(); !r.IsErrIs(context.Canceled) {
+		t.Errorf("Await() = %v, want %v", r, context.Canceled)
+	}
+}
+
+func TestAny(t *testing.T) {
+	checkNoLeak(t)
+	err := errors.New("error")
+	ctx := context.Background()
+
+	slow := Async(ctx, value(1, time.Hour))
+	got := Any(slow, Async(ctx, failure[int](err, 0)), Async(ctx, value(3, time.Millisecond)))
+	if !reflect.DeepEqual(got, Ok(3)) {
+		t.Errorf("Any() = %v, want %v", got, Ok(3))
+	}
+	if r := slow.Await(); !r.IsErrIs(context.Canceled) {
+		t.Errorf("Await() = %v, want %v", r, context.Canceled)
+	}
+
+	got = Any(Async(ctx, failure[int](err, 0)), Async(ctx, failure[int](err, time.Millisecond)))
+	if !got.IsErrIs(err) {
+		t.Errorf("Any() = %v, want %v", got, err)
+	}
+	if got := Any[int](); got.IsOk() {
+		t.Errorf("Any() = %v, want Err", got)
+	}
+}
+
+func TestRace(t *testing.T) {
+	checkNoLeak(t)
+	err1, err2 := errors.New("error1"), errors.New("error2")
+	ctx := context.Background()
+
+	slow := Async(ctx, value(1, time.Hour))
+	got := Race(slow, Async(ctx, failure[int](err1, 0)), Async(ctx, value(2, 10*time.Millisecond)))
+	if !reflect.DeepEqual(got, Ok(2)) {
+		t.Errorf("Race() = %v, want %v", got, Ok(2))
+	}
+	if r := slow.Await(); !r.IsErrIs(context.Canceled) {
+		t.Errorf("Await() = %v, want %v", r, context.Canceled)
+	}
+
+	got = Race(Async(ctx, failure[int](err1, 0)), Async(ctx, failure[int](err2, 10*time.Millisecond)))
+	if !reflect.DeepEqual(got, Err[int](err1)) {
+		t.Errorf("Race() = %v, want %v", got, Err[int](err1))
+	}
+}
+
+func TestAllSettled(t *testing.T) {
+	checkNoLeak(t)
+	err := errors.New("error")
+	ctx := context.Background()
+
+	got := AllSettled(Async(ctx, value(1, time.Millisecond)), Async(ctx, failure[int](err, 0)))
+	want := []Result[int]{Ok(1), Err[int](err)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AllSettled() = %v, want %v", got, want)
+	}
+}
@@ -1,5 +1,5 @@
 module github.com/Yuukirn/gutils
 
-go 1.18
+go 1.24
 
 require golang.org/x/exp v0.0.0-20240119083558-1b970713d09a
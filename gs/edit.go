@@ -0,0 +1,193 @@
+package gs
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/Yuukirn/gutils"
+	"golang.org/x/exp/constraints"
+)
+
+// ErrIndexOutOfRange is returned by the R variants of the index-based helpers.
+var ErrIndexOutOfRange = errors.New("gs: index out of range")
+
+// InsertAt returns a copy of s with ts inserted before index i.
+// It panics if i is not in [0, len(s)]; see InsertAtR.
+func InsertAt[T any](s []T, i int, ts ...T) []T {
+	return must(InsertAtR(s, i, ts...))
+}
+
+func InsertAtR[T any](s []T, i int, ts ...T) gutils.Result[[]T] {
+	return SpliceR(s, i, 0, ts...)
+}
+
+// RemoveAt returns a copy of s without the element at index i.
+// It panics if i is not in [0, len(s)); see RemoveAtR.
+func RemoveAt[T any](s []T, i int) []T {
+	return must(RemoveAtR(s, i))
+}
+
+func RemoveAtR[T any](s []T, i int) gutils.Result[[]T] {
+	if i < 0 || i >= len(s) {
+		return gutils.Err[[]T](outOfRange(i, len(s)))
+	}
+	return SpliceR(s, i, 1)
+}
+
+// Splice returns a copy of s with the n elements starting at index i replaced by ts.
+// It panics if i or n do not describe a range of s; see SpliceR.
+func Splice[T any](s []T, i, n int, ts ...T) []T {
+	return must(SpliceR(s, i, n, ts...))
+}
+
+func SpliceR[T any](s []T, i, n int, ts ...T) gutils.Result[[]T] {
+	if i < 0 || i > len(s) {
+		return gutils.Err[[]T](outOfRange(i, len(s)))
+	}
+	if n < 0 || i+n > len(s) {
+		return gutils.Err[[]T](outOfRange(i+n, len(s)))
+	}
+	var res = make([]T, 0, len(s)-n+len(ts))
+	res = append(res, s[:i]...)
+	res = append(res, ts...)
+	res = append(res, s[i+n:]...)
+	return gutils.Ok(res)
+}
+
+// Rotate returns a copy of s rotated left by k positions; a negative k rotates right.
+func Rotate[T any](s []T, k int) []T {
+	var res = make([]T, 0, len(s))
+	if len(s) == 0 {
+		return res
+	}
+	k %= len(s)
+	if k < 0 {
+		k += len(s)
+	}
+	res = append(res, s[k:]...)
+	return append(res, s[:k]...)
+}
+
+// Take returns a copy of the first n elements of s, or of all of them if s is shorter.
+func Take[T any](s []T, n int) []T {
+	return clone(s[:clamp(n, len(s))])
+}
+
+// TakeLast returns a copy of the last n elements of s, or of all of them if s is shorter.
+func TakeLast[T any](s []T, n int) []T {
+	return clone(s[len(s)-clamp(n, len(s)):])
+}
+
+// Drop returns a copy of s without its first n elements.
+func Drop[T any](s []T, n int) []T {
+	return clone(s[clamp(n, len(s)):])
+}
+
+// TakeWhile returns a copy of the longest prefix of s whose elements all satisfy f.
+func TakeWhile[T any](s []T, f func(t T) bool) []T {
+	return Take(s, prefixLen(s, f))
+}
+
+// DropWhile returns a copy of s without the longest prefix whose elements all satisfy f.
+func DropWhile[T any](s []T, f func(t T) bool) []T {
+	return Drop(s, prefixLen(s, f))
+}
+
+// Shuffle returns a copy of s in random order. The order is drawn from r,
+// so a seeded source gives reproducible results.
+func Shuffle[T any](s []T, r *rand.Rand) []T {
+	var res = clone(s)
+	r.Shuffle(len(res), func(i, j int) {
+		res[i], res[j] = res[j], res[i]
+	})
+	return res
+}
+
+// Sample returns n elements of s picked at random positions without replacement,
+// or all of them in random order if s is shorter. The choice is drawn from r.
+func Sample[T any](s []T, n int, r *rand.Rand) []T {
+	var res = clone(s)
+	n = clamp(n, len(res))
+	for i := 0; i < n; i++ {
+		j := i + r.Intn(len(res)-i)
+		res[i], res[j] = res[j], res[i]
+	}
+	return res[:n:n]
+}
+
+func Repeat[T any](t T, n int) []T {
+	if n < 0 {
+		n = 0
+	}
+	var res = make([]T, n)
+	for i := range res {
+		res[i] = t
+	}
+	return res
+}
+
+// Fill returns a slice of length n whose element at i is f(i).
+func Fill[T any](n int, f func(i int) T) []T {
+	if n < 0 {
+		n = 0
+	}
+	var res = make([]T, n)
+	for i := range res {
+		res[i] = f(i)
+	}
+	return res
+}
+
+// Range returns the numbers from start up to but not including end, stepping by step.
+// A negative step counts down. It returns an empty slice if step is zero
+// or points away from end. It stops before a step would overflow T.
+func Range[T constraints.Integer | constraints.Float](start, end, step T) []T {
+	var res = []T{}
+	if step == 0 {
+		return res
+	}
+	for i, prev := 0, start; ; i++ {
+		v := start + T(i)*step
+		// Past the first element, v must keep moving by step; otherwise T wrapped
+		// around or, for floats, step is lost to rounding.
+		if step > 0 && (v >= end || i > 0 && v <= prev) || step < 0 && (v <= end || i > 0 && v >= prev) {
+			return res
+		}
+		res = append(res, v)
+		prev = v
+	}
+}
+
+func clone[T any](s []T) []T {
+	var res = make([]T, len(s))
+	copy(res, s)
+	return res
+}
+
+// clamp limits n to [0, max].
+func clamp(n, max int) int {
+	switch {
+	case n < 0:
+		return 0
+	case n > max:
+		return max
+	}
+	return n
+}
+
+func prefixLen[T any](s []T, f func(t T) bool) int {
+	return FindIndex(s, func(t T) bool { return !f(t) }).UnwrapOr(len(s))
+}
+
+func outOfRange(i, n int) error {
+	return fmt.Errorf("%w: index %d, length %d", ErrIndexOutOfRange, i, n)
+}
+
+// must returns the value of r, or panics with its error.
+func must[T any](r gutils.Result[T]) T {
+	if r.IsErr() {
+		panic(r.ExpectErr(""))
+	}
+	return r.Unwrap()
+}
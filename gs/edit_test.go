@@ -0,0 +1,199 @@
+package gs
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSpliceR(t *testing.T) {
+	type args[T any] struct {
+		s  []T
+		i  int
+		n  int
+		ts []T
+	}
+	type testCase[T any] struct {
+		name    string
+		args    args[T]
+		want    []T
+		wantErr bool
+	}
+	tests := []testCase[int]{
+		{name: "SpliceRTest1", args: args[int]{[]int{1, 2, 3, 4}, 1, 2, []int{9}}, want: []int{1, 9, 4}},
+		{name: "SpliceRTest2", args: args[int]{[]int{1, 2}, 2, 0, []int{3, 4}}, want: []int{1, 2, 3, 4}},
+		{name: "SpliceRTest3", args: args[int]{[]int{1, 2}, 0, 2, nil}, want: []int{}},
+		{name: "SpliceRTest4", args: args[int]{[]int{1, 2}, 3, 0, nil}, wantErr: true},
+		{name: "SpliceRTest5", args: args[int]{[]int{1, 2}, 1, 2, nil}, wantErr: true},
+		{name: "SpliceRTest6", args: args[int]{[]int{1, 2}, -1, 0, nil}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := append([]int(nil), tt.args.s...)
+			got := SpliceR(s, tt.args.i, tt.args.n, tt.args.ts...)
+			if tt.wantErr {
+				if !got.IsErr() || !errors.Is(got.ExpectErr(""), ErrIndexOutOfRange) {
+					t.Errorf("SpliceR() = %v, want ErrIndexOutOfRange", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got.Unwrap(), tt.want) {
+				t.Errorf("SpliceR() = %v, want %v", got.Unwrap(), tt.want)
+			}
+			if !reflect.DeepEqual(s, tt.args.s) {
+				t.Errorf("SpliceR() modified input to %v", s)
+			}
+		})
+	}
+}
+
+func TestInsertAtRemoveAt(t *testing.T) {
+	s := []int{1, 2, 3}
+	if got := InsertAt(s, 1, 7, 8); !reflect.DeepEqual(got, []int{1, 7, 8, 2, 3}) {
+		t.Errorf("InsertAt() = %v, want %v", got, []int{1, 7, 8, 2, 3})
+	}
+	if got := RemoveAt(s, 2); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("RemoveAt() = %v, want %v", got, []int{1, 2})
+	}
+	if got := RemoveAtR(s, 3); !got.IsErr() {
+		t.Errorf("RemoveAtR() = %v, want Err", got)
+	}
+	if !reflect.DeepEqual(s, []int{1, 2, 3}) {
+		t.Errorf("input modified to %v", s)
+	}
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("RemoveAt() panicked with %v, want ErrIndexOutOfRange", err)
+		}
+	}()
+	RemoveAt(s, -1)
+}
+
+func TestRotate(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		s    []T
+		k    int
+		want []T
+	}
+	tests := []testCase[int]{
+		{name: "RotateTest1", s: []int{1, 2, 3, 4}, k: 1, want: []int{2, 3, 4, 1}},
+		{name: "RotateTest2", s: []int{1, 2, 3, 4}, k: -1, want: []int{4, 1, 2, 3}},
+		{name: "RotateTest3", s: []int{1, 2, 3, 4}, k: 6, want: []int{3, 4, 1, 2}},
+		{name: "RotateTest4", s: nil, k: 2, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Rotate(tt.s, tt.k); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Rotate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTakeDrop(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	small := func(t int) bool { return t < 3 }
+	type testCase struct {
+		name string
+		got  []int
+		want []int
+	}
+	tests := []testCase{
+		{name: "TakeTest1", got: Take(s, 2), want: []int{1, 2}},
+		{name: "TakeTest2", got: Take(s, 9), want: []int{1, 2, 3, 4, 5}},
+		{name: "TakeTest3", got: Take(s, -1), want: []int{}},
+		{name: "TakeLastTest1", got: TakeLast(s, 2), want: []int{4, 5}},
+		{name: "DropTest1", got: Drop(s, 2), want: []int{3, 4, 5}},
+		{name: "DropTest2", got: Drop(s, 9), want: []int{}},
+		{name: "TakeWhileTest1", got: TakeWhile(s, small), want: []int{1, 2}},
+		{name: "DropWhileTest1", got: DropWhile(s, small), want: []int{3, 4, 5}},
+		{name: "DropWhileTest2", got: DropWhile(s, func(int) bool { return true }), want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	got := Take(s, 2)
+	got[0] = 9
+	if s[0] != 1 {
+		t.Errorf("Take() aliases its input")
+	}
+}
+
+func TestShuffleSample(t *testing.T) {
+	s := Range(0, 20, 1)
+
+	a, b := Shuffle(s, rand.New(rand.NewSource(1))), Shuffle(s, rand.New(rand.NewSource(1)))
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Shuffle() = %v and %v with the same seed", a, b)
+	}
+	sort.Ints(a)
+	if !reflect.DeepEqual(a, s) {
+		t.Errorf("Shuffle() lost elements: %v", a)
+	}
+
+	got := Sample(s, 5, rand.New(rand.NewSource(1)))
+	if len(got) != 5 || len(Union(got, nil)) != 5 || !ContainsAll(s, got...) {
+		t.Errorf("Sample() = %v, want 5 distinct elements of %v", got, s)
+	}
+	if got := Sample(s, 50, rand.New(rand.NewSource(1))); len(got) != len(s) {
+		t.Errorf("Sample() len = %v, want %v", len(got), len(s))
+	}
+	if !reflect.DeepEqual(s, Range(0, 20, 1)) {
+		t.Errorf("input modified to %v", s)
+	}
+}
+
+func TestRange(t *testing.T) {
+	type testCase struct {
+		name string
+		got  []int
+		want []int
+	}
+	tests := []testCase{
+		{name: "RangeTest1", got: Range(0, 5, 2), want: []int{0, 2, 4}},
+		{name: "RangeTest2", got: Range(5, 0, -2), want: []int{5, 3, 1}},
+		{name: "RangeTest3", got: Range(0, 5, -1), want: []int{}},
+		{name: "RangeTest4", got: Range(0, 5, 0), want: []int{}},
+		{name: "RepeatTest1", got: Repeat(7, 3), want: []int{7, 7, 7}},
+		{name: "RepeatTest2", got: Repeat(7, -1), want: []int{}},
+		{name: "FillTest1", got: Fill(3, func(i int) int { return i * i }), want: []int{0, 1, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if got := Range[uint8](250, 255, 10); !reflect.DeepEqual(got, []uint8{250}) {
+		t.Errorf("Range() = %v, want %v", got, []uint8{250})
+	}
+	if got := Range[uint8](0, 255, 100); !reflect.DeepEqual(got, []uint8{0, 100, 200}) {
+		t.Errorf("Range() = %v, want %v", got, []uint8{0, 100, 200})
+	}
+	if got := Range[int8](120, 127, 5); !reflect.DeepEqual(got, []int8{120, 125}) {
+		t.Errorf("Range() = %v, want %v", got, []int8{120, 125})
+	}
+	if got := Range[int8](-120, -128, -5); !reflect.DeepEqual(got, []int8{-120, -125}) {
+		t.Errorf("Range() = %v, want %v", got, []int8{-120, -125})
+	}
+	if got := Range[int8](-128, 127, 127); !reflect.DeepEqual(got, []int8{-128, -1, 126}) {
+		t.Errorf("Range() = %v, want %v", got, []int8{-128, -1, 126})
+	}
+	if got := Range(1e20, 1e21, 1.0); len(got) != 1 {
+		t.Errorf("Range() len = %v, want %v", len(got), 1)
+	}
+	if got := Range(0, 0.5, 0.125); !reflect.DeepEqual(got, []float64{0, 0.125, 0.25, 0.375}) {
+		t.Errorf("Range() = %v, want %v", got, []float64{0, 0.125, 0.25, 0.375})
+	}
+}
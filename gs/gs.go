@@ -2,6 +2,7 @@ package gs
 
 import (
 	"github.com/Yuukirn/gutils"
+	"github.com/Yuukirn/gutils/pq"
 	"golang.org/x/exp/constraints"
 )
 
@@ -97,3 +98,81 @@ func FoldWith[T any](s []T, dv T, f func(T, T) T) T {
 	}
 	return res
 }
+
+// TopK returns the k greatest elements of s according to less, from greatest to least.
+func TopK[T any](s []T, k int, less func(T, T) bool) []T {
+	t := pq.NewTopK(k, less)
+	t.Add(s...)
+	return t.Result()
+}
+
+func MapIndexed[T, U any](s []T, f func(i int, t T) U) []U {
+	var res = make([]U, 0, len(s))
+	for i := range s {
+		res = append(res, f(i, s[i]))
+	}
+	return res
+}
+
+func FilterIndexed[T any](s []T, f func(i int, t T) bool) []T {
+	var res []T
+	for i := range s {
+		if f(i, s[i]) {
+			res = append(res, s[i])
+		}
+	}
+	return res
+}
+
+func Reduce[T, Acc any](s []T, init Acc, f func(Acc, T) Acc) Acc {
+	var res = init
+	for i := range s {
+		res = f(res, s[i])
+	}
+	return res
+}
+
+func ReduceRight[T, Acc any](s []T, init Acc, f func(Acc, T) Acc) Acc {
+	var res = init
+	for i := len(s) - 1; i >= 0; i-- {
+		res = f(res, s[i])
+	}
+	return res
+}
+
+// Scan returns every intermediate accumulator of Reduce, one per element.
+func Scan[T, Acc any](s []T, init Acc, f func(Acc, T) Acc) []Acc {
+	var res = make([]Acc, 0, len(s))
+	var acc = init
+	for i := range s {
+		acc = f(acc, s[i])
+		res = append(res, acc)
+	}
+	return res
+}
+
+func FlatMap[T, U any](s []T, f func(t T) []U) []U {
+	var res []U
+	for i := range s {
+		res = append(res, f(s[i])...)
+	}
+	return res
+}
+
+func Flatten[T any](s [][]T) []T {
+	var n int
+	for i := range s {
+		n += len(s[i])
+	}
+	var res = make([]T, 0, n)
+	for i := range s {
+		res = append(res, s[i]...)
+	}
+	return res
+}
+
+func ForEach[T any](s []T, f func(t T)) {
+	for i := range s {
+		f(s[i])
+	}
+}
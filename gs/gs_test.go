@@ -274,3 +274,167 @@ func TestFoldWith(t *testing.T) {
 		})
 	}
 }
+
+func TestTopK(t *testing.T) {
+	type args[T any] struct {
+		s    []T
+		k    int
+		less func(T, T) bool
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[string]{
+		{
+			name: "TopKTest1",
+			args: args[string]{[]string{"bb", "a", "dddd", "ccc"}, 2, func(a, b string) bool { return len(a) < len(b) }},
+			want: []string{"dddd", "ccc"},
+		},
+		{
+			name: "TopKTest2",
+			args: args[string]{nil, 2, func(a, b string) bool { return len(a) < len(b) }},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TopK(tt.args.s, tt.args.k, tt.args.less); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopK() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapIndexed(t *testing.T) {
+	type args[T, U any] struct {
+		s []T
+		f func(i int, t T) U
+	}
+	type testCase[T, U any] struct {
+		name string
+		args args[T, U]
+		want []U
+	}
+	tests := []testCase[string, string]{
+		{
+			name: "MapIndexedTest1",
+			args: args[string, string]{[]string{"a", "b"}, func(i int, t string) string { return strconv.Itoa(i) + t }},
+			want: []string{"0a", "1b"},
+		},
+		{
+			name: "MapIndexedTest2",
+			args: args[string, string]{nil, func(i int, t string) string { return t }},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapIndexed(tt.args.s, tt.args.f); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapIndexed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterIndexed(t *testing.T) {
+	type args[T any] struct {
+		s []T
+		f func(i int, t T) bool
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[string]{
+		{
+			name: "FilterIndexedTest1",
+			args: args[string]{[]string{"a", "b", "c"}, func(i int, t string) bool { return i%2 == 0 }},
+			want: []string{"a", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FilterIndexed(tt.args.s, tt.args.f); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterIndexed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	type order struct {
+		Item  string
+		Price int
+	}
+	type summary struct {
+		Items string
+		Total int
+	}
+	orders := []order{{"a", 1}, {"b", 2}, {"c", 3}}
+	f := func(acc summary, o order) summary {
+		return summary{acc.Items + o.Item, acc.Total + o.Price}
+	}
+
+	if got := Reduce(orders, summary{}, f); !reflect.DeepEqual(got, summary{"abc", 6}) {
+		t.Errorf("Reduce() = %v, want %v", got, summary{"abc", 6})
+	}
+	if got := ReduceRight(orders, summary{}, f); !reflect.DeepEqual(got, summary{"cba", 6}) {
+		t.Errorf("ReduceRight() = %v, want %v", got, summary{"cba", 6})
+	}
+	if got := Reduce(nil, summary{"x", 1}, f); !reflect.DeepEqual(got, summary{"x", 1}) {
+		t.Errorf("Reduce() = %v, want %v", got, summary{"x", 1})
+	}
+}
+
+func TestScan(t *testing.T) {
+	type args[T, Acc any] struct {
+		s    []T
+		init Acc
+		f    func(Acc, T) Acc
+	}
+	type testCase[T, Acc any] struct {
+		name string
+		args args[T, Acc]
+		want []Acc
+	}
+	tests := []testCase[int, int]{
+		{
+			name: "ScanTest1",
+			args: args[int, int]{[]int{1, 2, 3, 4}, 10, func(acc, t int) int { return acc + t }},
+			want: []int{11, 13, 16, 20},
+		},
+		{
+			name: "ScanTest2",
+			args: args[int, int]{nil, 10, func(acc, t int) int { return acc + t }},
+			want: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Scan(tt.args.s, tt.args.init, tt.args.f); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	dup := func(t int) []int { return []int{t, t} }
+	if got := FlatMap([]int{1, 2}, dup); !reflect.DeepEqual(got, []int{1, 1, 2, 2}) {
+		t.Errorf("FlatMap() = %v, want %v", got, []int{1, 1, 2, 2})
+	}
+	if got := Flatten([][]int{{1}, nil, {2, 3}}); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Flatten() = %v, want %v", got, []int{1, 2, 3})
+	}
+}
+
+func TestForEach(t *testing.T) {
+	var got []int
+	ForEach([]int{1, 2, 3}, func(t int) { got = append(got, t*2) })
+	if !reflect.DeepEqual(got, []int{2, 4, 6}) {
+		t.Errorf("ForEach() = %v, want %v", got, []int{2, 4, 6})
+	}
+}
@@ -0,0 +1,83 @@
+package gs
+
+import (
+	"github.com/Yuukirn/gutils"
+	"golang.org/x/exp/constraints"
+)
+
+func Find[T any](s []T, f func(t T) bool) gutils.Option[T] {
+	return gutils.MapO(FindIndex(s, f), func(i int) T { return s[i] })
+}
+
+func FindLast[T any](s []T, f func(t T) bool) gutils.Option[T] {
+	return gutils.MapO(FindLastIndex(s, f), func(i int) T { return s[i] })
+}
+
+func FindIndex[T any](s []T, f func(t T) bool) gutils.Option[int] {
+	for i := range s {
+		if f(s[i]) {
+			return gutils.Some(i)
+		}
+	}
+	return gutils.None[int]()
+}
+
+func FindLastIndex[T any](s []T, f func(t T) bool) gutils.Option[int] {
+	for i := len(s) - 1; i >= 0; i-- {
+		if f(s[i]) {
+			return gutils.Some(i)
+		}
+	}
+	return gutils.None[int]()
+}
+
+func IndexOf[T comparable](s []T, t T) gutils.Option[int] {
+	return FindIndex(s, func(e T) bool { return e == t })
+}
+
+func Any[T any](s []T, f func(t T) bool) bool {
+	return FindIndex(s, f).IsSome()
+}
+
+func All[T any](s []T, f func(t T) bool) bool {
+	for i := range s {
+		if !f(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func NoneMatch[T any](s []T, f func(t T) bool) bool {
+	return !Any(s, f)
+}
+
+func Count[T any](s []T, f func(t T) bool) int {
+	var res int
+	for i := range s {
+		if f(s[i]) {
+			res++
+		}
+	}
+	return res
+}
+
+// BinarySearch searches for t in the ascending slice s. It returns the index
+// of t and true if found, or the index where t would be inserted and false.
+func BinarySearch[T constraints.Ordered](s []T, t T) (int, bool) {
+	return BinarySearchBy(s, t, identity[T])
+}
+
+// BinarySearchBy is like BinarySearch for a slice sorted ascending by key.
+func BinarySearchBy[T any, K constraints.Ordered](s []T, k K, key func(T) K) (int, bool) {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if key(s[mid]) < k {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(s) && key(s[lo]) == k
+}
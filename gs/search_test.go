@@ -0,0 +1,146 @@
+package gs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Yuukirn/gutils"
+)
+
+func TestFind(t *testing.T) {
+	type args[T any] struct {
+		s []T
+		f func(t T) bool
+	}
+	type testCase[T any] struct {
+		name          string
+		args          args[T]
+		want          gutils.Option[T]
+		wantLast      gutils.Option[T]
+		wantIndex     gutils.Option[int]
+		wantLastIndex gutils.Option[int]
+	}
+	even := func(t int) bool { return t%2 == 0 }
+	tests := []testCase[int]{
+		{
+			name:          "FindTest1",
+			args:          args[int]{[]int{1, 2, 3, 4, 5}, even},
+			want:          gutils.Some(2),
+			wantLast:      gutils.Some(4),
+			wantIndex:     gutils.Some(1),
+			wantLastIndex: gutils.Some(3),
+		},
+		{
+			name:          "FindTest2",
+			args:          args[int]{[]int{1, 3, 5}, even},
+			want:          gutils.None[int](),
+			wantLast:      gutils.None[int](),
+			wantIndex:     gutils.None[int](),
+			wantLastIndex: gutils.None[int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Find(tt.args.s, tt.args.f); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Find() = %v, want %v", got, tt.want)
+			}
+			if got := FindLast(tt.args.s, tt.args.f); !reflect.DeepEqual(got, tt.wantLast) {
+				t.Errorf("FindLast() = %v, want %v", got, tt.wantLast)
+			}
+			if got := FindIndex(tt.args.s, tt.args.f); !reflect.DeepEqual(got, tt.wantIndex) {
+				t.Errorf("FindIndex() = %v, want %v", got, tt.wantIndex)
+			}
+			if got := FindLastIndex(tt.args.s, tt.args.f); !reflect.DeepEqual(got, tt.wantLastIndex) {
+				t.Errorf("FindLastIndex() = %v, want %v", got, tt.wantLastIndex)
+			}
+		})
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	type testCase[T comparable] struct {
+		name string
+		s    []T
+		t    T
+		want gutils.Option[int]
+	}
+	tests := []testCase[string]{
+		{name: "IndexOfTest1", s: []string{"a", "b", "b"}, t: "b", want: gutils.Some(1)},
+		{name: "IndexOfTest2", s: []string{"a", "b"}, t: "c", want: gutils.None[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexOf(tt.s, tt.t); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndexOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAny(t *testing.T) {
+	type testCase[T any] struct {
+		name      string
+		s         []T
+		wantAny   bool
+		wantAll   bool
+		wantNone  bool
+		wantCount int
+	}
+	even := func(t int) bool { return t%2 == 0 }
+	tests := []testCase[int]{
+		{name: "AnyTest1", s: []int{1, 2, 3}, wantAny: true, wantAll: false, wantNone: false, wantCount: 1},
+		{name: "AnyTest2", s: []int{2, 4}, wantAny: true, wantAll: true, wantNone: false, wantCount: 2},
+		{name: "AnyTest3", s: []int{1, 3}, wantAny: false, wantAll: false, wantNone: true, wantCount: 0},
+		{name: "AnyTest4", s: nil, wantAny: false, wantAll: true, wantNone: true, wantCount: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Any(tt.s, even); got != tt.wantAny {
+				t.Errorf("Any() = %v, want %v", got, tt.wantAny)
+			}
+			if got := All(tt.s, even); got != tt.wantAll {
+				t.Errorf("All() = %v, want %v", got, tt.wantAll)
+			}
+			if got := NoneMatch(tt.s, even); got != tt.wantNone {
+				t.Errorf("NoneMatch() = %v, want %v", got, tt.wantNone)
+			}
+			if got := Count(tt.s, even); got != tt.wantCount {
+				t.Errorf("Count() = %v, want %v", got, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	type testCase[T any] struct {
+		name      string
+		s         []T
+		t         T
+		want      int
+		wantFound bool
+	}
+	tests := []testCase[int]{
+		{name: "BinarySearchTest1", s: []int{1, 3, 5, 7}, t: 5, want: 2, wantFound: true},
+		{name: "BinarySearchTest2", s: []int{1, 3, 5, 7}, t: 4, want: 2, wantFound: false},
+		{name: "BinarySearchTest3", s: []int{1, 3, 5, 7}, t: 8, want: 4, wantFound: false},
+		{name: "BinarySearchTest4", s: []int{1, 3, 5, 7}, t: 0, want: 0, wantFound: false},
+		{name: "BinarySearchTest5", s: []int{1, 3, 3, 3}, t: 3, want: 1, wantFound: true},
+		{name: "BinarySearchTest6", s: nil, t: 3, want: 0, wantFound: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := BinarySearch(tt.s, tt.t)
+			if got != tt.want || found != tt.wantFound {
+				t.Errorf("BinarySearch() = (%v, %v), want (%v, %v)", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+
+	users := []user{{ID: 1}, {ID: 4}, {ID: 9}}
+	if got, found := BinarySearchBy(users, 4, userID); got != 1 || !found {
+		t.Errorf("BinarySearchBy() = (%v, %v), want (%v, %v)", got, found, 1, true)
+	}
+	if got, found := BinarySearchBy(users, 5, userID); got != 2 || found {
+		t.Errorf("BinarySearchBy() = (%v, %v), want (%v, %v)", got, found, 2, false)
+	}
+}
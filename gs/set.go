@@ -0,0 +1,119 @@
+package gs
+
+// The set operations below treat their inputs as sets: results contain each
+// element once, in the order of its first appearance in the first input.
+// They run in linear time using hash sets.
+
+func Intersect[T comparable](a, b []T) []T {
+	return IntersectBy(a, b, identity[T])
+}
+
+func IntersectBy[T any, K comparable](a, b []T, key func(T) K) []T {
+	inB := keySet(b, key)
+	seen := make(map[K]struct{}, len(a))
+	var res []T
+	for _, t := range a {
+		k := key(t)
+		if _, ok := inB[k]; ok && add(seen, k) {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func Union[T comparable](a, b []T) []T {
+	return UnionBy(a, b, identity[T])
+}
+
+func UnionBy[T any, K comparable](a, b []T, key func(T) K) []T {
+	seen := make(map[K]struct{}, len(a)+len(b))
+	var res []T
+	for _, s := range [][]T{a, b} {
+		for _, t := range s {
+			if add(seen, key(t)) {
+				res = append(res, t)
+			}
+		}
+	}
+	return res
+}
+
+func Difference[T comparable](a, b []T) []T {
+	return DifferenceBy(a, b, identity[T])
+}
+
+func DifferenceBy[T any, K comparable](a, b []T, key func(T) K) []T {
+	seen := keySet(b, key)
+	var res []T
+	for _, t := range a {
+		if add(seen, key(t)) {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return SymmetricDifferenceBy(a, b, identity[T])
+}
+
+func SymmetricDifferenceBy[T any, K comparable](a, b []T, key func(T) K) []T {
+	return append(DifferenceBy(a, b, key), DifferenceBy(b, a, key)...)
+}
+
+// Without returns the elements of s that are not among items. Unlike the set
+// operations, it keeps duplicates.
+func Without[T comparable](s []T, items ...T) []T {
+	return WithoutBy(s, identity[T], items...)
+}
+
+func WithoutBy[T any, K comparable](s []T, key func(T) K, items ...T) []T {
+	exclude := keySet(items, key)
+	var res []T
+	for _, t := range s {
+		if _, ok := exclude[key(t)]; !ok {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func ContainsAll[T comparable](s []T, items ...T) bool {
+	set := keySet(s, identity[T])
+	for _, t := range items {
+		if _, ok := set[t]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func ContainsAny[T comparable](s []T, items ...T) bool {
+	set := keySet(items, identity[T])
+	for _, t := range s {
+		if _, ok := set[t]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func identity[T any](t T) T {
+	return t
+}
+
+func keySet[T any, K comparable](s []T, key func(T) K) map[K]struct{} {
+	var res = make(map[K]struct{}, len(s))
+	for _, t := range s {
+		res[key(t)] = struct{}{}
+	}
+	return res
+}
+
+func add[K comparable](set map[K]struct{}, k K) bool {
+	if _, ok := set[k]; ok {
+		return false
+	}
+	set[k] = struct{}{}
+	return true
+}
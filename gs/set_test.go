@@ -0,0 +1,149 @@
+package gs
+
+import (
+	"reflect"
+	"testing"
+)
+
+type user struct {
+	ID   int
+	Tags []string
+}
+
+func userID(u user) int {
+	return u.ID
+}
+
+func TestSetOperations(t *testing.T) {
+	type testCase struct {
+		name string
+		f    func(a, b []int) []int
+		a, b []int
+		want []int
+	}
+	tests := []testCase{
+		{name: "IntersectTest1", f: Intersect[int], a: []int{3, 1, 2, 3, 4}, b: []int{4, 3, 5}, want: []int{3, 4}},
+		{name: "IntersectTest2", f: Intersect[int], a: []int{1, 2}, b: nil, want: nil},
+		{name: "UnionTest1", f: Union[int], a: []int{3, 1, 3}, b: []int{2, 1, 4}, want: []int{3, 1, 2, 4}},
+		{name: "UnionTest2", f: Union[int], a: nil, b: nil, want: nil},
+		{name: "DifferenceTest1", f: Difference[int], a: []int{3, 1, 2, 3, 4}, b: []int{4, 1}, want: []int{3, 2}},
+		{name: "DifferenceTest2", f: Difference[int], a: []int{1}, b: []int{1}, want: nil},
+		{name: "SymmetricDifferenceTest1", f: SymmetricDifference[int], a: []int{1, 2, 3, 2}, b: []int{4, 3, 5, 4}, want: []int{1, 2, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetOperationsBy(t *testing.T) {
+	a := []user{{ID: 1}, {ID: 2, Tags: []string{"x"}}, {ID: 3}}
+	b := []user{{ID: 2, Tags: []string{"y"}}, {ID: 4}}
+
+	type testCase struct {
+		name string
+		got  []user
+		want []int
+	}
+	tests := []testCase{
+		{name: "IntersectByTest", got: IntersectBy(a, b, userID), want: []int{2}},
+		{name: "UnionByTest", got: UnionBy(a, b, userID), want: []int{1, 2, 3, 4}},
+		{name: "DifferenceByTest", got: DifferenceBy(a, b, userID), want: []int{1, 3}},
+		{name: "SymmetricDifferenceByTest", got: SymmetricDifferenceBy(a, b, userID), want: []int{1, 3, 4}},
+		{name: "WithoutByTest", got: WithoutBy(a, userID, user{ID: 3}), want: []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Map(tt.got, userID); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	if got := IntersectBy(a, b, userID); !reflect.DeepEqual(got[0].Tags, []string{"x"}) {
+		t.Errorf("IntersectBy() kept %v, want the element of the first input", got[0])
+	}
+}
+
+func TestWithout(t *testing.T) {
+	type args[T comparable] struct {
+		s     []T
+		items []T
+	}
+	type testCase[T comparable] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "WithoutTest1",
+			args: args[int]{[]int{1, 2, 1, 3, 2}, []int{2}},
+			want: []int{1, 1, 3},
+		},
+		{
+			name: "WithoutTest2",
+			args: args[int]{[]int{1, 2}, nil},
+			want: []int{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Without(tt.args.s, tt.args.items...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Without() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	type args[T comparable] struct {
+		s     []T
+		items []T
+	}
+	type testCase[T comparable] struct {
+		name    string
+		args    args[T]
+		wantAll bool
+		wantAny bool
+	}
+	tests := []testCase[int]{
+		{
+			name:    "ContainsAllTest1",
+			args:    args[int]{[]int{1, 2, 3}, []int{3, 1}},
+			wantAll: true,
+			wantAny: true,
+		},
+		{
+			name:    "ContainsAllTest2",
+			args:    args[int]{[]int{1, 2, 3}, []int{3, 4}},
+			wantAll: false,
+			wantAny: true,
+		},
+		{
+			name:    "ContainsAllTest3",
+			args:    args[int]{[]int{1, 2, 3}, []int{4, 5}},
+			wantAll: false,
+			wantAny: false,
+		},
+		{
+			name:    "ContainsAllTest4",
+			args:    args[int]{[]int{1, 2, 3}, nil},
+			wantAll: true,
+			wantAny: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsAll(tt.args.s, tt.args.items...); got != tt.wantAll {
+				t.Errorf("ContainsAll() = %v, want %v", got, tt.wantAll)
+			}
+			if got := ContainsAny(tt.args.s, tt.args.items...); got != tt.wantAny {
+				t.Errorf("ContainsAny() = %v, want %v", got, tt.wantAny)
+			}
+		})
+	}
+}
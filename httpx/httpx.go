@@ -0,0 +1,82 @@
+package httpx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/Yuukirn/gutils"
+	"github.com/Yuukirn/gutils/errs"
+)
+
+type requestKey struct{}
+
+// Handle adapts f to an http.Handler.
+//
+// A non-empty request body is decoded as JSON into Req; optional fields can be
+// declared as gutils.Option and stay None when they are missing or null.
+// A body that fails to decode is answered with 400 without calling f.
+//
+// An Ok result is written as JSON with status 200. An Err result is written
+// with the status of its errs.Code; its body is the errs.Error in the chain,
+// or just the code when there is none, so messages of uncoded errors never leak.
+func Handle[Req, Resp any](f func(ctx context.Context, req Req) gutils.Result[Resp]) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Req
+		if err := decode(r, &req); err != nil {
+			writeError(w, errs.Wrap(err, errs.InvalidArgument, "invalid request body"))
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), requestKey{}, r)
+		res := f(ctx, req)
+		if res.IsErr() {
+			writeError(w, res.ExpectErr(""))
+			return
+		}
+		writeJSON(w, http.StatusOK, res.Unwrap())
+	})
+}
+
+// RequestFrom returns the request being served by a Handle handler.
+func RequestFrom(ctx context.Context) gutils.Option[*http.Request] {
+	r, ok := ctx.Value(requestKey{}).(*http.Request)
+	return gutils.FromOk(r, ok)
+}
+
+func decode(r *http.Request, v any) error {
+	if r.Body == nil || r.ContentLength == 0 {
+		return nil
+	}
+	err := json.NewDecoder(r.Body).Decode(v)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code := errs.CodeOf(err)
+	var e *errs.Error
+	if !errors.As(err, &e) {
+		e = errs.New(code, "")
+	}
+	writeJSON(w, code.HTTPStatus(), e)
+}
+
+// writeJSON encodes v before writing the header, so a value that fails to
+// encode is answered with 500 instead of status and an empty body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		status = errs.Internal.HTTPStatus()
+		buf.Reset()
+		_ = json.NewEncoder(&buf).Encode(errs.New(errs.Internal, ""))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
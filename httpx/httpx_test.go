@@ -0,0 +1,188 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Yuukirn/gutils"
+	"github.com/Yuukirn/gutils/errs"
+)
+
+type createUser struct {
+	Name string                `json:"name"`
+	Age  gutils.Option[int]    `json:"age"`
+	Nick gutils.Option[string] `json:"nick"`
+}
+
+type user struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func createUserHandler(ctx context.Context, req createUser) gutils.Result[user] {
+	switch req.Name {
+	case "":
+		return gutils.ErrInvalidArgument[user]("name is required")
+	case "taken":
+		return gutils.ErrAlreadyExists[user]("name is taken")
+	case "boom":
+		return gutils.Err[user](errors.New("secret database error"))
+	}
+	return gutils.Ok(user{ID: 1, Name: req.Name, Age: req.Age.UnwrapOr(18)})
+}
+
+func TestHandle(t *testing.T) {
+	type testCase struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}
+	tests := []testCase{
+		{
+			name:       "HandleTest1",
+			body:       `{"name":"foo","age":30}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":1,"name":"foo","age":30}`,
+		},
+		{
+			name:       "HandleTest2",
+			body:       `{"name":"foo","age":null}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":1,"name":"foo","age":18}`,
+		},
+		{
+			name:       "HandleTest3",
+			body:       ``,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"code":"invalid_argument","message":"name is required"}`,
+		},
+		{
+			name:       "HandleTest4",
+			body:       `{"name":`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"code":"invalid_argument","message":"invalid request body"}`,
+		},
+		{
+			name:       "HandleTest5",
+			body:       `{"name":"taken"}`,
+			wantStatus: http.StatusConflict,
+			wantBody:   `{"code":"already_exists","message":"name is taken"}`,
+		},
+		{
+			name:       "HandleTest6",
+			body:       `{"name":"boom"}`,
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"code":"unknown"}`,
+		},
+	}
+	h := Handle(createUserHandler)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(tt.body)))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", w.Code, tt.wantStatus)
+			}
+			if got := strings.TrimSpace(w.Body.String()); got != tt.wantBody {
+				t.Errorf("body = %v, want %v", got, tt.wantBody)
+			}
+			if got := w.Header().Get("Content-Type"); got != "application/json" {
+				t.Errorf("Content-Type = %v, want %v", got, "application/json")
+			}
+		})
+	}
+}
+
+func TestHandle_EncodeError(t *testing.T) {
+	h := Handle(func(ctx context.Context, req struct{}) gutils.Result[float64] {
+		return gutils.Ok(math.NaN())
+	})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %v, want %v", w.Code, http.StatusInternalServerError)
+	}
+	if got, want := strings.TrimSpace(w.Body.String()), `{"code":"internal"}`; got != want {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+}
+
+func TestParams(t *testing.T) {
+	type result struct {
+		ID    string
+		Limit gutils.Option[int]
+		Sort  gutils.Option[string]
+	}
+	var got gutils.Result[result]
+	h := Handle(func(ctx context.Context, _ struct{}) gutils.Result[struct{}] {
+		r := RequestFrom(ctx).Unwrap()
+		got = gutils.MapR(QueryAs(r, "limit", strconv.Atoi), func(limit gutils.Option[int]) result {
+			return result{ID: PathValue(r, "id").UnwrapOr(""), Limit: limit, Sort: Query(r, "sort")}
+		})
+		return gutils.AndR(got, gutils.Ok(struct{}{}))
+	})
+	mux := http.NewServeMux()
+	mux.Handle("GET /users/{id}", h)
+
+	type testCase struct {
+		name       string
+		url        string
+		want       gutils.Result[result]
+		wantStatus int
+	}
+	tests := []testCase{
+		{
+			name:       "ParamsTest1",
+			url:        "/users/7?limit=10&sort=name",
+			want:       gutils.Ok(result{ID: "7", Limit: gutils.Some(10), Sort: gutils.Some("name")}),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "ParamsTest2",
+			url:        "/users/7",
+			want:       gutils.Ok(result{ID: "7"}),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "ParamsTest3",
+			url:        "/users/7?limit=ten",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", w.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if got.Code() != errs.InvalidArgument {
+					t.Errorf("Code() = %v, want %v", got.Code(), errs.InvalidArgument)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("params = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestFrom(t *testing.T) {
+	if got := RequestFrom(context.Background()); got.IsSome() {
+		t.Errorf("RequestFrom() = %v, want None", got)
+	}
+}
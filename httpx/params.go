@@ -0,0 +1,45 @@
+package httpx
+
+import (
+	"net/http"
+
+	"github.com/Yuukirn/gutils"
+	"github.com/Yuukirn/gutils/errs"
+)
+
+// Query returns the first value of the query parameter key, or None if it is absent.
+func Query(r *http.Request, key string) gutils.Option[string] {
+	vs, ok := r.URL.Query()[key]
+	if !ok || len(vs) == 0 {
+		return gutils.None[string]()
+	}
+	return gutils.Some(vs[0])
+}
+
+// PathValue returns the path wildcard name matched by an http.ServeMux pattern
+// such as "GET /users/{id}", or None if it is empty. Patterns need Go 1.22.
+func PathValue(r *http.Request, name string) gutils.Option[string] {
+	return gutils.FromZero(r.PathValue(name))
+}
+
+// QueryAs parses the query parameter key with parse. An absent parameter is
+// Ok(None); a malformed one is an errs.InvalidArgument error.
+func QueryAs[T any](r *http.Request, key string, parse func(string) (T, error)) gutils.Result[gutils.Option[T]] {
+	return parseParam(Query(r, key), "query parameter "+key, parse)
+}
+
+// PathValueAs is QueryAs for path wildcards.
+func PathValueAs[T any](r *http.Request, name string, parse func(string) (T, error)) gutils.Result[gutils.Option[T]] {
+	return parseParam(PathValue(r, name), "path parameter "+name, parse)
+}
+
+func parseParam[T any](o gutils.Option[string], what string, parse func(string) (T, error)) gutils.Result[gutils.Option[T]] {
+	if o.IsNone() {
+		return gutils.Ok(gutils.None[T]())
+	}
+	t, err := parse(o.Some())
+	if err != nil {
+		return gutils.Err[gutils.Option[T]](errs.Wrap(err, errs.InvalidArgument, "invalid "+what))
+	}
+	return gutils.Ok(gutils.Some(t))
+}
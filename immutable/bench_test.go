@@ -0,0 +1,73 @@
+package immutable
+
+import (
+	"testing"
+
+	"github.com/Yuukirn/gutils/stream"
+)
+
+const benchSize = 10000
+
+func BenchmarkList_Append(b *testing.B) {
+	l := FromSlice(make([]int, benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = l.Append(i)
+	}
+}
+
+func BenchmarkSliceStream_Append(b *testing.B) {
+	s := make([]int, benchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = stream.NewSliceStream(s).Append([]int{i})
+	}
+}
+
+func BenchmarkList_Set(b *testing.B) {
+	l := FromSlice(make([]int, benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = l.Set(i%benchSize, i)
+	}
+}
+
+func BenchmarkSliceStream_Set(b *testing.B) {
+	s := make([]int, benchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c := stream.NewSliceStream(s).ToSlice()
+		c[i%benchSize] = i
+	}
+}
+
+func BenchmarkList_Build(b *testing.B) {
+	s := make([]int, benchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = FromSlice(s)
+	}
+}
+
+func BenchmarkMap_Set(b *testing.B) {
+	m := NewMapBuilder[int, int]()
+	for i := 0; i < benchSize; i++ {
+		m.Set(i, i)
+	}
+	pm := m.Build()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = pm.Set(i%benchSize, i)
+	}
+}
+
+func BenchmarkMapStream_Set(b *testing.B) {
+	m := make(map[int]int, benchSize)
+	for i := 0; i < benchSize; i++ {
+		m[i] = i
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = stream.NewMapStream(m).Merge(map[int]int{i % benchSize: i})
+	}
+}
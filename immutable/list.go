@@ -0,0 +1,319 @@
+package immutable
+
+import "github.com/Yuukirn/gutils"
+
+const (
+	nodeBits  = 5
+	nodeWidth = 1 << nodeBits
+	nodeMask  = nodeWidth - 1
+)
+
+type listNode[T any] struct {
+	children []*listNode[T]
+	values   []T
+}
+
+// List is a persistent vector. Every update returns a new List that shares
+// all unchanged nodes with the old one, so both stay valid and unchanged.
+// Get, Set, Append and Pop take effectively constant time.
+// The zero value is an empty List.
+type List[T any] struct {
+	size  int
+	shift uint
+	root  *listNode[T]
+	tail  []T
+}
+
+func NewList[T any](ts ...T) List[T] {
+	return FromSlice(ts)
+}
+
+func FromSlice[T any](s []T) List[T] {
+	var b ListBuilder[T]
+	b.Append(s...)
+	return b.Build()
+}
+
+func (l List[T]) Len() int {
+	return l.size
+}
+
+func (l List[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+func (l List[T]) Get(i int) gutils.Option[T] {
+	if i < 0 || i >= l.size {
+		return gutils.None[T]()
+	}
+	return gutils.Some(l.leafFor(i)[i&nodeMask])
+}
+
+func (l List[T]) First() gutils.Option[T] {
+	return l.Get(0)
+}
+
+func (l List[T]) Last() gutils.Option[T] {
+	return l.Get(l.size - 1)
+}
+
+func (l List[T]) Append(ts ...T) List[T] {
+	for _, t := range ts {
+		l = l.push(t)
+	}
+	return l
+}
+
+// Set returns a List with the element at i replaced by t.
+// It panics if i is out of range.
+func (l List[T]) Set(i int, t T) List[T] {
+	l.checkIndex(i)
+	if i >= l.tailOffset() {
+		tail := make([]T, len(l.tail))
+		copy(tail, l.tail)
+		tail[i&nodeMask] = t
+		l.tail = tail
+		return l
+	}
+	l.root = l.setIn(l.shift, l.root, i, t)
+	return l
+}
+
+// Pop returns the List without its last element. Popping an empty List returns it unchanged.
+func (l List[T]) Pop() List[T] {
+	if l.size <= 1 {
+		return List[T]{}
+	}
+	if l.size-l.tailOffset() > 1 {
+		l.tail = l.tail[: len(l.tail)-1 : len(l.tail)-1]
+		l.size--
+		return l
+	}
+
+	tail := l.leafFor(l.size - 2)
+	root := l.popTail(l.shift, l.root)
+	shift := l.shift
+	if root == nil {
+		root = &listNode[T]{}
+	}
+	if shift > nodeBits && len(root.children) == 1 {
+		root = root.children[0]
+		shift -= nodeBits
+	}
+	return List[T]{size: l.size - 1, shift: shift, root: root, tail: tail}
+}
+
+// Delete returns the List without the element at i. Unlike the other updates,
+// it copies the elements after i and takes linear time. It panics if i is out of range.
+func (l List[T]) Delete(i int) List[T] {
+	l.checkIndex(i)
+	if i == l.size-1 {
+		return l.Pop()
+	}
+
+	var b ListBuilder[T]
+	b.values = make([]T, 0, l.size-1)
+	l.Range(func(j int, t T) bool {
+		if j != i {
+			b.values = append(b.values, t)
+		}
+		return true
+	})
+	return b.Build()
+}
+
+// Range calls f for every element in order until f returns false.
+func (l List[T]) Range(f func(i int, t T) bool) {
+	for i := 0; i < l.size; i += nodeWidth {
+		leaf := l.leafFor(i)
+		for j := range leaf {
+			if !f(i+j, leaf[j]) {
+				return
+			}
+		}
+	}
+}
+
+func (l List[T]) ToSlice() []T {
+	var res = make([]T, 0, l.size)
+	l.Range(func(_ int, t T) bool {
+		res = append(res, t)
+		return true
+	})
+	return res
+}
+
+// Builder returns a ListBuilder initialized with the elements of l.
+func (l List[T]) Builder() *ListBuilder[T] {
+	return &ListBuilder[T]{values: l.ToSlice()}
+}
+
+func (l List[T]) tailOffset() int {
+	if l.size < nodeWidth {
+		return 0
+	}
+	return ((l.size - 1) >> nodeBits) << nodeBits
+}
+
+func (l List[T]) leafFor(i int) []T {
+	if i >= l.tailOffset() {
+		return l.tail
+	}
+	n := l.root
+	for level := l.shift; level > 0; level -= nodeBits {
+		n = n.children[(i>>level)&nodeMask]
+	}
+	return n.values
+}
+
+func (l List[T]) checkIndex(i int) {
+	if i < 0 || i >= l.size {
+		panic("immutable: index out of range")
+	}
+}
+
+func (l List[T]) push(t T) List[T] {
+	if l.root == nil {
+		l.root = &listNode[T]{}
+		l.shift = nodeBits
+	}
+	if l.size-l.tailOffset() < nodeWidth {
+		tail := make([]T, len(l.tail)+1)
+		copy(tail, l.tail)
+		tail[len(l.tail)] = t
+		return List[T]{size: l.size + 1, shift: l.shift, root: l.root, tail: tail}
+	}
+
+	leaf := &listNode[T]{values: l.tail}
+	root, shift := l.root, l.shift
+	if (l.size >> nodeBits) > (1 << l.shift) {
+		root = &listNode[T]{children: []*listNode[T]{l.root, newPath(l.shift, leaf)}}
+		shift += nodeBits
+	} else {
+		root = l.pushTail(l.shift, l.root, leaf)
+	}
+	return List[T]{size: l.size + 1, shift: shift, root: root, tail: []T{t}}
+}
+
+func (l List[T]) pushTail(level uint, parent, leaf *listNode[T]) *listNode[T] {
+	i := ((l.size - 1) >> level) & nodeMask
+	res := &listNode[T]{children: make([]*listNode[T], len(parent.children), i+1)}
+	copy(res.children, parent.children)
+
+	var child *listNode[T]
+	switch {
+	case level == nodeBits:
+		child = leaf
+	case i < len(parent.children):
+		child = l.pushTail(level-nodeBits, parent.children[i], leaf)
+	default:
+		child = newPath(level-nodeBits, leaf)
+	}
+
+	if i < len(res.children) {
+		res.children[i] = child
+	} else {
+		res.children = append(res.children, child)
+	}
+	return res
+}
+
+func (l List[T]) popTail(level uint, n *listNode[T]) *listNode[T] {
+	i := ((l.size - 2) >> level) & nodeMask
+	if level > nodeBits {
+		child := l.popTail(level-nodeBits, n.children[i])
+		if child == nil && i == 0 {
+			return nil
+		}
+		res := &listNode[T]{children: make([]*listNode[T], i+1)}
+		copy(res.children, n.children)
+		if child == nil {
+			res.children = res.children[:i]
+		} else {
+			res.children[i] = child
+		}
+		return res
+	}
+	if i == 0 {
+		return nil
+	}
+	res := &listNode[T]{children: make([]*listNode[T], i)}
+	copy(res.children, n.children)
+	return res
+}
+
+func (l List[T]) setIn(level uint, n *listNode[T], i int, t T) *listNode[T] {
+	if level == 0 {
+		res := &listNode[T]{values: make([]T, len(n.values))}
+		copy(res.values, n.values)
+		res.values[i&nodeMask] = t
+		return res
+	}
+	res := &listNode[T]{children: make([]*listNode[T], len(n.children))}
+	copy(res.children, n.children)
+	j := (i >> level) & nodeMask
+	res.children[j] = l.setIn(level-nodeBits, n.children[j], i, t)
+	return res
+}
+
+func newPath[T any](level uint, n *listNode[T]) *listNode[T] {
+	if level == 0 {
+		return n
+	}
+	return &listNode[T]{children: []*listNode[T]{newPath(level-nodeBits, n)}}
+}
+
+// ListBuilder collects elements for a List in a plain slice and builds the
+// tree in one pass, which is much cheaper than repeated Append for bulk loads.
+// The zero value is ready to use.
+type ListBuilder[T any] struct {
+	values []T
+}
+
+func NewListBuilder[T any]() *ListBuilder[T] {
+	return &ListBuilder[T]{}
+}
+
+func (b *ListBuilder[T]) Append(ts ...T) *ListBuilder[T] {
+	b.values = append(b.values, ts...)
+	return b
+}
+
+func (b *ListBuilder[T]) Len() int {
+	return len(b.values)
+}
+
+// Build returns the List and resets the builder.
+func (b *ListBuilder[T]) Build() List[T] {
+	values := b.values
+	b.values = nil
+
+	l := List[T]{size: len(values), shift: nodeBits, root: &listNode[T]{}}
+	if l.size == 0 {
+		return List[T]{}
+	}
+	off := l.tailOffset()
+	l.tail = values[off:len(values):len(values)]
+
+	var nodes []*listNode[T]
+	for i := 0; i < off; i += nodeWidth {
+		nodes = append(nodes, &listNode[T]{values: values[i : i+nodeWidth : i+nodeWidth]})
+	}
+	if len(nodes) == 0 {
+		return l
+	}
+	for len(nodes) > nodeWidth {
+		var parents []*listNode[T]
+		for i := 0; i < len(nodes); i += nodeWidth {
+			end := i + nodeWidth
+			if end > len(nodes) {
+				end = len(nodes)
+			}
+			parents = append(parents, &listNode[T]{children: nodes[i:end:end]})
+		}
+		nodes = parents
+		l.shift += nodeBits
+	}
+	l.root = &listNode[T]{children: nodes}
+	return l
+}
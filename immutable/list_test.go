@@ -0,0 +1,120 @@
+package immutable
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/Yuukirn/gutils"
+)
+
+func TestList_Append(t *testing.T) {
+	for _, n := range []int{0, 1, 31, 32, 33, 1024, 1056, 1057, 33000} {
+		var l List[int]
+		var want []int
+		for i := 0; i < n; i++ {
+			l = l.Append(i)
+			want = append(want, i)
+		}
+		if got := l.ToSlice(); len(got) != len(want) || (n > 0 && !reflect.DeepEqual(got, want)) {
+			t.Fatalf("ToSlice() len = %v, want %v", len(got), len(want))
+		}
+		if got := FromSlice(want); !reflect.DeepEqual(got.ToSlice(), l.ToSlice()) || got.Len() != n {
+			t.Fatalf("FromSlice() len = %v, want %v", got.Len(), n)
+		}
+		for i := 0; i < n; i++ {
+			if got := l.Get(i); !reflect.DeepEqual(got, gutils.Some(i)) {
+				t.Fatalf("Get(%v) = %v, want %v", i, got, gutils.Some(i))
+			}
+		}
+		if got := l.Get(n); got.IsSome() {
+			t.Fatalf("Get(%v) = %v, want %v", n, got, gutils.None[int]())
+		}
+	}
+}
+
+func TestList_Persistence(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	l := FromSlice(make([]int, 2000))
+	model := make([]int, 2000)
+
+	versions := []List[int]{l}
+	models := [][]int{append([]int(nil), model...)}
+	for step := 0; step < 3000; step++ {
+		switch op := r.Intn(10); {
+		case op < 4:
+			v := r.Int()
+			l = l.Append(v)
+			model = append(model, v)
+		case op < 7 && len(model) > 0:
+			i, v := r.Intn(len(model)), r.Int()
+			l = l.Set(i, v)
+			model[i] = v
+		case op < 9:
+			l = l.Pop()
+			if len(model) > 0 {
+				model = model[:len(model)-1]
+			}
+		case len(model) > 0:
+			i := r.Intn(len(model))
+			l = l.Delete(i)
+			model = append(model[:i], model[i+1:]...)
+		}
+		if step%100 == 0 {
+			versions = append(versions, l)
+			models = append(models, append([]int(nil), model...))
+		}
+	}
+
+	for i := range versions {
+		got := versions[i].ToSlice()
+		if len(got) != len(models[i]) || (len(got) > 0 && !reflect.DeepEqual(got, models[i])) {
+			t.Fatalf("version %v = len %v, want len %v", i, len(got), len(models[i]))
+		}
+	}
+}
+
+func TestList_Pop(t *testing.T) {
+	l := NewList(1, 2, 3)
+	if got := l.Pop().ToSlice(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("Pop() = %v, want %v", got, []int{1, 2})
+	}
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("ToSlice() = %v, want %v", got, []int{1, 2, 3})
+	}
+	if got := (List[int]{}).Pop(); got.Len() != 0 {
+		t.Errorf("Pop() = %v, want empty", got.ToSlice())
+	}
+}
+
+func TestList_Set(t *testing.T) {
+	l := NewList(1, 2, 3)
+	l2 := l.Set(1, 5)
+	if got := l2.ToSlice(); !reflect.DeepEqual(got, []int{1, 5, 3}) {
+		t.Errorf("Set() = %v, want %v", got, []int{1, 5, 3})
+	}
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("ToSlice() = %v, want %v", got, []int{1, 2, 3})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Set() did not panic")
+		}
+	}()
+	l.Set(3, 0)
+}
+
+func TestListBuilder(t *testing.T) {
+	b := NewList(1, 2).Builder()
+	b.Append(3, 4)
+	if got := b.Len(); got != 4 {
+		t.Errorf("Len() = %v, want %v", got, 4)
+	}
+	if got := b.Build().ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Build() = %v, want %v", got, []int{1, 2, 3, 4})
+	}
+	if got := b.Len(); got != 0 {
+		t.Errorf("Len() = %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,334 @@
+package immutable
+
+import (
+	"hash/maphash"
+	"math/bits"
+
+	"github.com/Yuukirn/gutils"
+)
+
+var seed = maphash.MakeSeed()
+
+// editToken marks the nodes a MapBuilder owns and may update in place.
+type editToken struct {
+	_ byte
+}
+
+type entry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+	child *mapNode[K, V]
+}
+
+// mapNode is a node of a hash array mapped trie. Below the last level of the
+// hash it is a collision node, whose entries are all leaves with the same hash.
+type mapNode[K comparable, V any] struct {
+	bitmap  uint32
+	entries []entry[K, V]
+	edit    *editToken
+}
+
+// Map is a persistent hash map based on a hash array mapped trie. Every update
+// returns a new Map that shares all unchanged nodes with the old one.
+// The zero value is an empty Map.
+type Map[K comparable, V any] struct {
+	root *mapNode[K, V]
+	size int
+}
+
+func FromMap[K comparable, V any](m map[K]V) Map[K, V] {
+	b := NewMapBuilder[K, V]()
+	for k, v := range m {
+		b.Set(k, v)
+	}
+	return b.Build()
+}
+
+func (m Map[K, V]) Len() int {
+	return m.size
+}
+
+func (m Map[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+func (m Map[K, V]) Get(k K) gutils.Option[V] {
+	if m.root == nil {
+		return gutils.None[V]()
+	}
+	return m.root.get(maphash.Comparable(seed, k), 0, k)
+}
+
+func (m Map[K, V]) ContainsKey(k K) bool {
+	return m.Get(k).IsSome()
+}
+
+func (m Map[K, V]) Set(k K, v V) Map[K, V] {
+	root, added := m.root.set(nil, maphash.Comparable(seed, k), 0, k, v)
+	if added {
+		return Map[K, V]{root: root, size: m.size + 1}
+	}
+	return Map[K, V]{root: root, size: m.size}
+}
+
+func (m Map[K, V]) Delete(k K) Map[K, V] {
+	if m.root == nil {
+		return m
+	}
+	root, removed := m.root.delete(nil, maphash.Comparable(seed, k), 0, k)
+	if !removed {
+		return m
+	}
+	return Map[K, V]{root: root, size: m.size - 1}
+}
+
+// Range calls f for every entry in an unspecified order until f returns false.
+func (m Map[K, V]) Range(f func(k K, v V) bool) {
+	if m.root != nil {
+		m.root.each(f)
+	}
+}
+
+func (m Map[K, V]) Keys() []K {
+	var res = make([]K, 0, m.size)
+	m.Range(func(k K, _ V) bool {
+		res = append(res, k)
+		return true
+	})
+	return res
+}
+
+func (m Map[K, V]) Values() []V {
+	var res = make([]V, 0, m.size)
+	m.Range(func(_ K, v V) bool {
+		res = append(res, v)
+		return true
+	})
+	return res
+}
+
+func (m Map[K, V]) ToMap() map[K]V {
+	var res = make(map[K]V, m.size)
+	m.Range(func(k K, v V) bool {
+		res[k] = v
+		return true
+	})
+	return res
+}
+
+// Builder returns a MapBuilder starting from m. It shares the nodes of m and
+// copies each of them at most once, on its first update.
+func (m Map[K, V]) Builder() *MapBuilder[K, V] {
+	return &MapBuilder[K, V]{m: m, edit: &editToken{}}
+}
+
+// MapBuilder updates a Map in place for bulk loads. Maps built earlier are never affected.
+type MapBuilder[K comparable, V any] struct {
+	m    Map[K, V]
+	edit *editToken
+}
+
+func NewMapBuilder[K comparable, V any]() *MapBuilder[K, V] {
+	return Map[K, V]{}.Builder()
+}
+
+func (b *MapBuilder[K, V]) Set(k K, v V) *MapBuilder[K, V] {
+	root, added := b.m.root.set(b.edit, maphash.Comparable(seed, k), 0, k, v)
+	b.m.root = root
+	if added {
+		b.m.size++
+	}
+	return b
+}
+
+func (b *MapBuilder[K, V]) Delete(k K) *MapBuilder[K, V] {
+	if b.m.root == nil {
+		return b
+	}
+	root, removed := b.m.root.delete(b.edit, maphash.Comparable(seed, k), 0, k)
+	b.m.root = root
+	if removed {
+		b.m.size--
+	}
+	return b
+}
+
+func (b *MapBuilder[K, V]) Len() int {
+	return b.m.size
+}
+
+// Build returns the Map. The builder can keep being used without affecting it.
+func (b *MapBuilder[K, V]) Build() Map[K, V] {
+	b.edit = &editToken{}
+	return b.m
+}
+
+func (n *mapNode[K, V]) get(hash uint64, shift uint, k K) gutils.Option[V] {
+	if shift >= 64 {
+		for i := range n.entries {
+			if n.entries[i].key == k {
+				return gutils.Some(n.entries[i].value)
+			}
+		}
+		return gutils.None[V]()
+	}
+
+	bit := bitFor(hash, shift)
+	if n.bitmap&bit == 0 {
+		return gutils.None[V]()
+	}
+	e := &n.entries[n.index(bit)]
+	if e.child != nil {
+		return e.child.get(hash, shift+nodeBits, k)
+	}
+	if e.key != k {
+		return gutils.None[V]()
+	}
+	return gutils.Some(e.value)
+}
+
+func (n *mapNode[K, V]) set(edit *editToken, hash uint64, shift uint, k K, v V) (*mapNode[K, V], bool) {
+	leaf := entry[K, V]{hash: hash, key: k, value: v}
+	if n == nil {
+		return &mapNode[K, V]{bitmap: bitFor(hash, shift), entries: []entry[K, V]{leaf}, edit: edit}, true
+	}
+
+	if shift >= 64 {
+		for i := range n.entries {
+			if n.entries[i].key == k {
+				res := n.editable(edit)
+				res.entries[i].value = v
+				return res, false
+			}
+		}
+		res := n.editable(edit)
+		res.entries = append(res.entries, leaf)
+		return res, true
+	}
+
+	bit := bitFor(hash, shift)
+	i := n.index(bit)
+	if n.bitmap&bit == 0 {
+		res := n.editable(edit)
+		res.bitmap |= bit
+		res.entries = append(res.entries, entry[K, V]{})
+		copy(res.entries[i+1:], res.entries[i:])
+		res.entries[i] = leaf
+		return res, true
+	}
+
+	e := n.entries[i]
+	switch {
+	case e.child != nil:
+		child, added := e.child.set(edit, hash, shift+nodeBits, k, v)
+		res := n.editable(edit)
+		res.entries[i].child = child
+		return res, added
+	case e.key == k:
+		res := n.editable(edit)
+		res.entries[i].value = v
+		return res, false
+	default:
+		res := n.editable(edit)
+		res.entries[i] = entry[K, V]{child: merge(edit, e, leaf, shift+nodeBits)}
+		return res, true
+	}
+}
+
+func (n *mapNode[K, V]) delete(edit *editToken, hash uint64, shift uint, k K) (*mapNode[K, V], bool) {
+	if shift >= 64 {
+		for i := range n.entries {
+			if n.entries[i].key == k {
+				return n.without(edit, i, 0), true
+			}
+		}
+		return n, false
+	}
+
+	bit := bitFor(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	i := n.index(bit)
+	e := n.entries[i]
+	if e.child == nil {
+		if e.key != k {
+			return n, false
+		}
+		return n.without(edit, i, bit), true
+	}
+
+	child, removed := e.child.delete(edit, hash, shift+nodeBits, k)
+	if !removed {
+		return n, false
+	}
+	switch {
+	case len(child.entries) == 0:
+		return n.without(edit, i, bit), true
+	case len(child.entries) == 1 && child.entries[0].child == nil:
+		res := n.editable(edit)
+		res.entries[i] = child.entries[0]
+		return res, true
+	default:
+		res := n.editable(edit)
+		res.entries[i].child = child
+		return res, true
+	}
+}
+
+func (n *mapNode[K, V]) each(f func(k K, v V) bool) bool {
+	for i := range n.entries {
+		e := &n.entries[i]
+		if e.child != nil {
+			if !e.child.each(f) {
+				return false
+			}
+		} else if !f(e.key, e.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// editable returns n itself if the builder owning edit may update it in place, or a copy of it.
+func (n *mapNode[K, V]) editable(edit *editToken) *mapNode[K, V] {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+	entries := make([]entry[K, V], len(n.entries), len(n.entries)+1)
+	copy(entries, n.entries)
+	return &mapNode[K, V]{bitmap: n.bitmap, entries: entries, edit: edit}
+}
+
+func (n *mapNode[K, V]) without(edit *editToken, i int, bit uint32) *mapNode[K, V] {
+	res := n.editable(edit)
+	res.bitmap &^= bit
+	copy(res.entries[i:], res.entries[i+1:])
+	res.entries[len(res.entries)-1] = entry[K, V]{}
+	res.entries = res.entries[:len(res.entries)-1]
+	return res
+}
+
+func (n *mapNode[K, V]) index(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func merge[K comparable, V any](edit *editToken, a, b entry[K, V], shift uint) *mapNode[K, V] {
+	if shift >= 64 {
+		return &mapNode[K, V]{entries: []entry[K, V]{a, b}, edit: edit}
+	}
+
+	ba, bb := bitFor(a.hash, shift), bitFor(b.hash, shift)
+	if ba == bb {
+		return &mapNode[K, V]{bitmap: ba, entries: []entry[K, V]{{child: merge(edit, a, b, shift+nodeBits)}}, edit: edit}
+	}
+	if ba > bb {
+		a, b = b, a
+	}
+	return &mapNode[K, V]{bitmap: ba | bb, entries: []entry[K, V]{a, b}, edit: edit}
+}
+
+func bitFor(hash uint64, shift uint) uint32 {
+	return 1 << ((hash >> shift) & nodeMask)
+}
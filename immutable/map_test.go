@@ -0,0 +1,136 @@
+package immutable
+
+import (
+	"maps"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/Yuukirn/gutils"
+)
+
+type collidingKey struct {
+	hi, lo int
+}
+
+func TestMap_Set(t *testing.T) {
+	var m Map[string, int]
+	m1 := m.Set("a", 1)
+	m2 := m1.Set("b", 2)
+	m3 := m2.Set("a", 3)
+
+	tests := []struct {
+		name string
+		m    Map[string, int]
+		want map[string]int
+	}{
+		{name: "Map_SetTest1", m: m, want: map[string]int{}},
+		{name: "Map_SetTest2", m: m1, want: map[string]int{"a": 1}},
+		{name: "Map_SetTest3", m: m2, want: map[string]int{"a": 1, "b": 2}},
+		{name: "Map_SetTest4", m: m3, want: map[string]int{"a": 3, "b": 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.ToMap(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToMap() = %v, want %v", got, tt.want)
+			}
+			if got := tt.m.Len(); got != len(tt.want) {
+				t.Errorf("Len() = %v, want %v", got, len(tt.want))
+			}
+		})
+	}
+
+	if got := m3.Get("a"); !reflect.DeepEqual(got, gutils.Some(3)) {
+		t.Errorf("Get() = %v, want %v", got, gutils.Some(3))
+	}
+	if got := m3.Get("c"); got.IsSome() {
+		t.Errorf("Get() = %v, want %v", got, gutils.None[int]())
+	}
+}
+
+func TestMap_Persistence(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var m Map[int, int]
+	model := map[int]int{}
+
+	var versions []Map[int, int]
+	var models []map[int]int
+	for step := 0; step < 20000; step++ {
+		k := r.Intn(5000)
+		if r.Intn(3) == 0 {
+			m = m.Delete(k)
+			delete(model, k)
+		} else {
+			v := r.Int()
+			m = m.Set(k, v)
+			model[k] = v
+		}
+		if step%1000 == 0 {
+			versions = append(versions, m)
+			models = append(models, maps.Clone(model))
+		}
+	}
+
+	for i := range versions {
+		if got := versions[i].ToMap(); !reflect.DeepEqual(got, models[i]) {
+			t.Fatalf("version %v has %v entries, want %v", i, len(got), len(models[i]))
+		}
+		if got := versions[i].Len(); got != len(models[i]) {
+			t.Fatalf("version %v Len() = %v, want %v", i, got, len(models[i]))
+		}
+	}
+}
+
+func TestMap_Collisions(t *testing.T) {
+	// Insert keys with identical hashes directly to exercise collision nodes.
+	var root *mapNode[collidingKey, int]
+	for i := 0; i < 3; i++ {
+		root, _ = root.set(nil, 42, 0, collidingKey{1, i}, i)
+	}
+	m := Map[collidingKey, int]{root: root, size: 3}
+
+	for i := 0; i < 3; i++ {
+		if got := m.root.get(42, 0, collidingKey{1, i}); !reflect.DeepEqual(got, gutils.Some(i)) {
+			t.Errorf("get() = %v, want %v", got, gutils.Some(i))
+		}
+	}
+	root, removed := m.root.delete(nil, 42, 0, collidingKey{1, 1})
+	if !removed {
+		t.Fatalf("delete() removed = %v, want %v", removed, true)
+	}
+	if got := root.get(42, 0, collidingKey{1, 1}); got.IsSome() {
+		t.Errorf("get() = %v, want %v", got, gutils.None[int]())
+	}
+	if got := root.get(42, 0, collidingKey{1, 2}); !reflect.DeepEqual(got, gutils.Some(2)) {
+		t.Errorf("get() = %v, want %v", got, gutils.Some(2))
+	}
+	if got := m.root.get(42, 0, collidingKey{1, 1}); !reflect.DeepEqual(got, gutils.Some(1)) {
+		t.Errorf("get() = %v, want %v", got, gutils.Some(1))
+	}
+}
+
+func TestMapBuilder(t *testing.T) {
+	base := FromMap(map[string]int{"a": 1, "b": 2})
+	b := base.Builder()
+	b.Set("c", 3).Delete("a")
+	m1 := b.Build()
+	b.Set("d", 4)
+	m2 := b.Build()
+
+	tests := []struct {
+		name string
+		m    Map[string, int]
+		want map[string]int
+	}{
+		{name: "MapBuilderTest1", m: base, want: map[string]int{"a": 1, "b": 2}},
+		{name: "MapBuilderTest2", m: m1, want: map[string]int{"b": 2, "c": 3}},
+		{name: "MapBuilderTest3", m: m2, want: map[string]int{"b": 2, "c": 3, "d": 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.ToMap(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
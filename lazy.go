@@ -0,0 +1,97 @@
+package gutils
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy computes a value on first use. It is safe for concurrent use.
+// If the function panics, that Get and every later one panic with the same value.
+type Lazy[T any] struct {
+	once     sync.Once
+	done     uint32
+	f        func() T
+	value    T
+	panicked bool
+	panicVal any
+}
+
+func NewLazy[T any](f func() T) *Lazy[T] {
+	return &Lazy[T]{f: f}
+}
+
+func (l *Lazy[T]) Get() T {
+	l.once.Do(func() {
+		defer func() {
+			if p := recover(); p != nil {
+				l.panicked, l.panicVal = true, p
+				l.f = nil
+				panic(p)
+			}
+		}()
+		l.value = l.f()
+		l.f = nil
+		atomic.StoreUint32(&l.done, 1)
+	})
+	if l.panicked {
+		panic(l.panicVal)
+	}
+	return l.value
+}
+
+// Peek returns the value if it has already been computed, without computing it.
+func (l *Lazy[T]) Peek() Option[T] {
+	if atomic.LoadUint32(&l.done) == 0 {
+		return None[T]()
+	}
+	return Some(l.value)
+}
+
+// LazyResult computes a Result on first use. By default an Err is cached like
+// an Ok; after RetryOnErr, the next Get calls the function again instead.
+type LazyResult[T any] struct {
+	mu       sync.Mutex
+	done     uint32
+	retryErr bool
+	f        func() Result[T]
+	res      Result[T]
+}
+
+func NewLazyResult[T any](f func() Result[T]) *LazyResult[T] {
+	return &LazyResult[T]{f: f}
+}
+
+func (l *LazyResult[T]) RetryOnErr() *LazyResult[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.retryErr = true
+	return l
+}
+
+func (l *LazyResult[T]) Get() Result[T] {
+	if atomic.LoadUint32(&l.done) == 1 {
+		return l.res
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.done == 1 {
+		return l.res
+	}
+
+	res := l.f()
+	if res.IsErr() && l.retryErr {
+		return res
+	}
+	l.res = res
+	l.f = nil
+	atomic.StoreUint32(&l.done, 1)
+	return l.res
+}
+
+func (l *LazyResult[T]) Peek() Option[Result[T]] {
+	if atomic.LoadUint32(&l.done) == 0 {
+		return None[Result[T]]()
+	}
+	return Some(l.res)
+}
@@ -0,0 +1,118 @@
+package gutils
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy_Get(t *testing.T) {
+	var calls int32
+	l := NewLazy(func() int {
+		atomic.AddInt32(&calls, 1)
+		return 2
+	})
+
+	if got := l.Peek(); !reflect.DeepEqual(got, None[int]()) {
+		t.Errorf("Peek() = %v, want %v", got, None[int]())
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := l.Get(); got != 2 {
+				t.Errorf("Get() = %v, want %v", got, 2)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("f called %v times, want 1", calls)
+	}
+	if got := l.Peek(); !reflect.DeepEqual(got, Some(2)) {
+		t.Errorf("Peek() = %v, want %v", got, Some(2))
+	}
+}
+
+func TestLazy_GetPanic(t *testing.T) {
+	var calls int
+	l := NewLazy(func() int {
+		calls++
+		panic("boom")
+	})
+
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				if p := recover(); p != "boom" {
+					t.Errorf("Get() panicked with %v, want %v", p, "boom")
+				}
+			}()
+			l.Get()
+		}()
+	}
+	if calls != 1 {
+		t.Errorf("f called %v times, want 1", calls)
+	}
+	if got := l.Peek(); !reflect.DeepEqual(got, None[int]()) {
+		t.Errorf("Peek() = %v, want %v", got, None[int]())
+	}
+}
+
+func TestLazyResult_Get(t *testing.T) {
+	err := errors.New("error")
+	type testCase struct {
+		name      string
+		retry     bool
+		want      []Result[int]
+		wantCalls int
+	}
+	tests := []testCase{
+		{
+			name:      "LazyResult_GetTest1",
+			retry:     false,
+			want:      []Result[int]{Err[int](err), Err[int](err), Err[int](err)},
+			wantCalls: 1,
+		},
+		{
+			name:      "LazyResult_GetTest2",
+			retry:     true,
+			want:      []Result[int]{Err[int](err), Ok(2), Ok(2)},
+			wantCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			l := NewLazyResult(func() Result[int] {
+				calls++
+				if calls == 1 {
+					return Err[int](err)
+				}
+				return Ok(2)
+			})
+			if tt.retry {
+				l.RetryOnErr()
+			}
+
+			var got []Result[int]
+			for range tt.want {
+				got = append(got, l.Get())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("f called %v times, want %v", calls, tt.wantCalls)
+			}
+			if p := l.Peek(); !reflect.DeepEqual(p, Some(tt.want[len(tt.want)-1])) {
+				t.Errorf("Peek() = %v, want %v", p, Some(tt.want[len(tt.want)-1]))
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package gutils
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrorEntry is a single failure recorded by a MultiError.
+type ErrorEntry struct {
+	// Key is the index or map key the failure was added under.
+	Key any
+	// Path locates the failure from the outermost MultiError, such as "users[2].email".
+	Path string
+	Err  error
+}
+
+func (e ErrorEntry) String() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+// MultiError accumulates labeled failures. errors.Is and errors.As match any of them.
+// The zero value is an empty MultiError ready to use, and every method except Add
+// also accepts a nil *MultiError as an empty one.
+type MultiError struct {
+	Entries []ErrorEntry
+}
+
+// Add records err under key, which is usually an index or a map key. Nil errors are ignored.
+// If err is itself a MultiError its entries are added one by one, with their paths
+// nested under key.
+func (e *MultiError) Add(key any, err error) *MultiError {
+	if err == nil {
+		return e
+	}
+	path := keyPath(key)
+	if me, ok := err.(*MultiError); ok {
+		for _, ent := range me.entries() {
+			e.Entries = append(e.Entries, ErrorEntry{Key: key, Path: joinKeyPath(path, ent.Path), Err: ent.Err})
+		}
+		return e
+	}
+	e.Entries = append(e.Entries, ErrorEntry{Key: key, Path: path, Err: err})
+	return e
+}
+
+func (e *MultiError) Len() int {
+	return len(e.entries())
+}
+
+// Filter returns a MultiError with the entries for which f returns true.
+func (e *MultiError) Filter(f func(ErrorEntry) bool) *MultiError {
+	var res = &MultiError{}
+	for _, ent := range e.entries() {
+		if f(ent) {
+			res.Entries = append(res.Entries, ent)
+		}
+	}
+	return res
+}
+
+// GroupByType splits the entries by the dynamic type of their errors.
+func (e *MultiError) GroupByType() map[reflect.Type]*MultiError {
+	var res = make(map[reflect.Type]*MultiError)
+	for _, ent := range e.entries() {
+		t := reflect.TypeOf(ent.Err)
+		if _, exist := res[t]; !exist {
+			res[t] = &MultiError{}
+		}
+		res[t].Entries = append(res[t].Entries, ent)
+	}
+	return res
+}
+
+// ErrOrNil returns e, or nil if it has no entries.
+func (e *MultiError) ErrOrNil() error {
+	if e.Len() == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *MultiError) Error() string {
+	entries := e.entries()
+	switch len(entries) {
+	case 0:
+		return "no errors"
+	case 1:
+		return entries[0].String()
+	}
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(len(entries)))
+	b.WriteString(" errors occurred:")
+	for _, ent := range e.entries() {
+		b.WriteString("\n\t* ")
+		b.WriteString(strings.ReplaceAll(ent.String(), "\n", "\n\t  "))
+	}
+	return b.String()
+}
+
+func (e *MultiError) Unwrap() []error {
+	var res = make([]error, 0, e.Len())
+	for _, ent := range e.entries() {
+		res = append(res, ent.Err)
+	}
+	return res
+}
+
+// MarshalJSON encodes e as a list of {"key", "path", "error"} objects.
+func (e *MultiError) MarshalJSON() ([]byte, error) {
+	type jsonEntry struct {
+		Key   any    `json:"key,omitempty"`
+		Path  string `json:"path,omitempty"`
+		Error string `json:"error"`
+	}
+	var res = make([]jsonEntry, 0, e.Len())
+	for _, ent := range e.entries() {
+		res = append(res, jsonEntry{Key: ent.Key, Path: ent.Path, Error: ent.Err.Error()})
+	}
+	return json.Marshal(res)
+}
+
+func (e *MultiError) entries() []ErrorEntry {
+	if e == nil {
+		return nil
+	}
+	return e.Entries
+}
+
+// CollectR returns the values of rs if they are all Ok, or a MultiError
+// with every error keyed by its index.
+func CollectR[T any](rs []Result[T]) Result[[]T] {
+	var me MultiError
+	var res = make([]T, 0, len(rs))
+	for i := range rs {
+		if rs[i].IsErr() {
+			me.Add(i, rs[i].err)
+			continue
+		}
+		res = append(res, rs[i].ok)
+	}
+	if me.Len() > 0 {
+		return Err[[]T](&me)
+	}
+	return Ok(res)
+}
+
+// CollectMapR is CollectR for maps. Errors are keyed by map key, and sorted by
+// key when keys are integers, floats or strings, or by path otherwise.
+func CollectMapR[K comparable, V any](m map[K]Result[V]) Result[map[K]V] {
+	var me MultiError
+	var res = make(map[K]V, len(m))
+	for k, r := range m {
+		if r.IsErr() {
+			me.Add(k, r.err)
+			continue
+		}
+		res[k] = r.ok
+	}
+	if me.Len() > 0 {
+		sort.SliceStable(me.Entries, func(i, j int) bool {
+			if c, ok := compareKeys(me.Entries[i].Key, me.Entries[j].Key); ok {
+				return c < 0
+			}
+			return me.Entries[i].Path < me.Entries[j].Path
+		})
+		return Err[map[K]V](&me)
+	}
+	return Ok(res)
+}
+
+// compareKeys compares two keys of the same ordered kind. It returns false if
+// their kind has no order.
+func compareKeys(a, b any) (int, bool) {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() || va.Kind() != vb.Kind() {
+		return 0, false
+	}
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(va.Int(), vb.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(va.Uint(), vb.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(va.Float(), vb.Float()), true
+	case reflect.String:
+		return cmp.Compare(va.String(), vb.String()), true
+	}
+	return 0, false
+}
+
+func keyPath(key any) string {
+	switch k := key.(type) {
+	case nil:
+		return ""
+	case int:
+		return "[" + strconv.Itoa(k) + "]"
+	case string:
+		return k
+	default:
+		return fmt.Sprint(k)
+	}
+}
+
+func joinKeyPath(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "" || strings.HasPrefix(b, "["):
+		return a + b
+	default:
+		return a + "." + b
+	}
+}
@@ -0,0 +1,171 @@
+package gutils
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMultiError_Add(t *testing.T) {
+	var inner MultiError
+	inner.Add("email", io.EOF).Add("tags", new(MultiError).Add(1, os.ErrNotExist))
+
+	var me MultiError
+	me.Add(0, nil).Add(2, &inner).Add("name", os.ErrClosed)
+
+	want := []ErrorEntry{
+		{Key: 2, Path: "[2].email", Err: io.EOF},
+		{Key: 2, Path: "[2].tags[1]", Err: os.ErrNotExist},
+		{Key: "name", Path: "name", Err: os.ErrClosed},
+	}
+	if !reflect.DeepEqual(me.Entries, want) {
+		t.Errorf("Entries = %v, want %v", me.Entries, want)
+	}
+	if got := me.Len(); got != 3 {
+		t.Errorf("Len() = %v, want %v", got, 3)
+	}
+	if !errors.Is(&me, os.ErrNotExist) || errors.Is(&me, os.ErrPermission) {
+		t.Errorf("errors.Is() does not match the children of %v", &me)
+	}
+	var pe *os.PathError
+	me.Add(3, &os.PathError{Op: "open", Path: "x", Err: os.ErrNotExist})
+	if !errors.As(&me, &pe) || pe.Path != "x" {
+		t.Errorf("errors.As() = %v, want the *os.PathError", pe)
+	}
+}
+
+func TestMultiError_Error(t *testing.T) {
+	type testCase struct {
+		name string
+		me   *MultiError
+		want string
+	}
+	tests := []testCase{
+		{
+			name: "ErrorTest1",
+			me:   new(MultiError).Add("name", errors.New("is required")),
+			want: "name: is required",
+		},
+		{
+			name: "ErrorTest2",
+			me:   new(MultiError).Add(0, errors.New("a\nb")).Add(nil, errors.New("c")),
+			want: "2 errors occurred:\n\t* [0]: a\n\t  b\n\t* c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.me.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiError_Filter(t *testing.T) {
+	me := new(MultiError).Add(0, io.EOF).Add(1, errors.New("a")).Add(2, io.ErrUnexpectedEOF).Add(3, errors.New("b"))
+
+	got := me.Filter(func(e ErrorEntry) bool { return e.Key.(int)%2 == 0 })
+	if want := []error{io.EOF, io.ErrUnexpectedEOF}; !reflect.DeepEqual(got.Unwrap(), want) {
+		t.Errorf("Filter() = %v, want %v", got.Unwrap(), want)
+	}
+
+	groups := me.GroupByType()
+	if got := groups[reflect.TypeOf(io.EOF)].Len(); got != 4 {
+		t.Errorf("GroupByType() len = %v, want %v", got, 4)
+	}
+	me.Add(4, &os.PathError{})
+	if got := me.GroupByType()[reflect.TypeOf(&os.PathError{})].Len(); got != 1 {
+		t.Errorf("GroupByType() len = %v, want %v", got, 1)
+	}
+}
+
+func TestMultiError_MarshalJSON(t *testing.T) {
+	me := new(MultiError).Add(1, errors.New("a")).Add(nil, errors.New("b"))
+	got, err := json.Marshal(me)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `[{"key":1,"path":"[1]","error":"a"},{"error":"b"}]`; string(got) != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestMultiError_ErrOrNil(t *testing.T) {
+	var me *MultiError
+	if got := me.ErrOrNil(); got != nil {
+		t.Errorf("ErrOrNil() = %v, want nil", got)
+	}
+	if got := new(MultiError).Add(0, nil).ErrOrNil(); got != nil {
+		t.Errorf("ErrOrNil() = %v, want nil", got)
+	}
+}
+
+func TestMultiError_Nil(t *testing.T) {
+	var me *MultiError
+	if got := me.Error(); got != "no errors" {
+		t.Errorf("Error() = %v, want %v", got, "no errors")
+	}
+	if got := me.Filter(func(ErrorEntry) bool { return true }).Len(); got != 0 {
+		t.Errorf("Filter() len = %v, want %v", got, 0)
+	}
+	if got := me.GroupByType(); len(got) != 0 {
+		t.Errorf("GroupByType() = %v, want empty", got)
+	}
+	if got := me.Unwrap(); len(got) != 0 {
+		t.Errorf("Unwrap() = %v, want empty", got)
+	}
+	if got, err := me.MarshalJSON(); err != nil || string(got) != "[]" {
+		t.Errorf("MarshalJSON() = %s, %v, want []", got, err)
+	}
+	if got := new(MultiError).Add(0, me).Len(); got != 0 {
+		t.Errorf("Add() len = %v, want %v", got, 0)
+	}
+}
+
+func TestCollectR(t *testing.T) {
+	if got := CollectR([]Result[int]{Ok(1), Ok(2)}); !reflect.DeepEqual(got, Ok([]int{1, 2})) {
+		t.Errorf("CollectR() = %v, want %v", got, Ok([]int{1, 2}))
+	}
+
+	got := CollectR([]Result[int]{Ok(1), Err[int](io.EOF), Ok(3), Err[int](os.ErrClosed)})
+	want := []ErrorEntry{{Key: 1, Path: "[1]", Err: io.EOF}, {Key: 3, Path: "[3]", Err: os.ErrClosed}}
+	if me := ErrAs[*MultiError](got); me.IsNone() || !reflect.DeepEqual(me.Some().Entries, want) {
+		t.Errorf("CollectR() = %v, want entries %v", got, want)
+	}
+}
+
+func TestCollectMapR(t *testing.T) {
+	m := map[string]Result[int]{"a": Ok(1), "b": Ok(2)}
+	if got := CollectMapR(m); !reflect.DeepEqual(got, Ok(map[string]int{"a": 1, "b": 2})) {
+		t.Errorf("CollectMapR() = %v, want %v", got, Ok(map[string]int{"a": 1, "b": 2}))
+	}
+
+	m = map[string]Result[int]{"c": Err[int](os.ErrClosed), "a": Ok(1), "b": Err[int](io.EOF)}
+	got := CollectMapR(m)
+	if want := "2 errors occurred:\n\t* b: EOF\n\t* c: file already closed"; !got.IsErr() || got.ExpectErr("").Error() != want {
+		t.Errorf("CollectMapR() = %v, want %v", got, want)
+	}
+}
+
+func TestCollectMapR_IntKeys(t *testing.T) {
+	var m = make(map[int]Result[int])
+	var want []string
+	for i := 0; i < 12; i++ {
+		m[i] = Err[int](io.EOF)
+		want = append(want, "["+strconv.Itoa(i)+"]")
+	}
+
+	var paths []string
+	if me := ErrAs[*MultiError](CollectMapR(m)); me.IsSome() {
+		for _, e := range me.Some().Entries {
+			paths = append(paths, e.Path)
+		}
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("CollectMapR() paths = %v, want %v", paths, want)
+	}
+}
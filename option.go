@@ -3,11 +3,17 @@ package gutils
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
 )
 
+// Option is either Some value or None.
+// The zero value of Option is None.
 type Option[T any] struct {
-	some T
-	none bool
+	some  T
+	valid bool
 }
 
 func (o Option[T]) And(optb Option[T]) Option[T] {
@@ -104,6 +110,66 @@ func MapOrElseO[T, U any](o Option[T], df func() U, f func(t T) U) U {
 	return f(o.Some())
 }
 
+func MatchO[T, U any](o Option[T], onSome func(t T) U, onNone func() U) U {
+	if o.IsNone() {
+		return onNone()
+	}
+	return onSome(o.Some())
+}
+
+// Filter returns o if it is Some and f returns true for its value, and None otherwise.
+func (o Option[T]) Filter(f func(t T) bool) Option[T] {
+	if o.IsSome() && f(o.Some()) {
+		return o
+	}
+	return None[T]()
+}
+
+// Inspect calls f with the value of o if it is Some, and returns o.
+func (o Option[T]) Inspect(f func(t T)) Option[T] {
+	if o.IsSome() {
+		f(o.Some())
+	}
+	return o
+}
+
+// Tap calls f with o, and returns o.
+func (o Option[T]) Tap(f func(o Option[T])) Option[T] {
+	f(o)
+	return o
+}
+
+func FlattenO[T any](o Option[Option[T]]) Option[T] {
+	if o.IsNone() {
+		return None[T]()
+	}
+	return o.Some()
+}
+
+// TransposeO turns None into Ok(None), and Some(r) into r with its value wrapped in Some.
+func TransposeO[T any](o Option[Result[T]]) Result[Option[T]] {
+	if o.IsNone() {
+		return Ok(None[T]())
+	}
+	r := o.Some()
+	if r.IsErr() {
+		return Err[Option[T]](r.err)
+	}
+	return Ok(Some(r.ok))
+}
+
+func ContainsO[T comparable](o Option[T], t T) bool {
+	return o.IsSome() && o.Some() == t
+}
+
+// EqualO reports whether a and b are both None, or both Some with equal values.
+func EqualO[T comparable](a, b Option[T]) bool {
+	if a.IsNone() || b.IsNone() {
+		return a.IsNone() == b.IsNone()
+	}
+	return a.Some() == b.Some()
+}
+
 func (o Option[T]) OkOr(err error) Result[T] {
 	if o.IsNone() {
 		return Err[T](err)
@@ -130,7 +196,7 @@ func (o Option[T]) IsSomeAnd(f func(t T) bool) bool {
 }
 
 func (o Option[T]) IsNone() bool {
-	return o.none
+	return !o.valid
 }
 
 func (o Option[T]) Some() T {
@@ -151,24 +217,52 @@ func (o Option[T]) GetOrElse(dv T) T {
 	return o.Some()
 }
 
-func (o Option[T]) GetOrInsert(dv T) T {
+func (o *Option[T]) GetOrInsert(dv T) T {
 	if o.IsNone() {
-		o.some = dv
-		o.none = false
-		return dv
+		o.Insert(dv)
 	}
 	return o.Some()
 }
 
-func (o Option[T]) GetOrInsertWith(f func() T) T {
+func (o *Option[T]) GetOrInsertWith(f func() T) T {
 	if o.IsNone() {
-		o.some = f()
-		o.none = false
-		return o.Some()
+		o.Insert(f())
 	}
 	return o.Some()
 }
 
+func (o *Option[T]) Insert(t T) T {
+	o.some = t
+	o.valid = true
+	return t
+}
+
+func (o *Option[T]) Take() Option[T] {
+	res := *o
+	*o = None[T]()
+	return res
+}
+
+func (o *Option[T]) TakeIf(f func(t *T) bool) Option[T] {
+	if o.IsSome() && f(&o.some) {
+		return o.Take()
+	}
+	return None[T]()
+}
+
+func (o *Option[T]) Replace(t T) Option[T] {
+	res := *o
+	*o = Some(t)
+	return res
+}
+
+func (o *Option[T]) AsPtr() *T {
+	if o.IsNone() {
+		return nil
+	}
+	return &o.some
+}
+
 func (o Option[T]) Unwrap() T {
 	if o.IsNone() {
 		panic(errors.New("called `Option::Unwrap()` on a `None` value"))
@@ -197,6 +291,52 @@ func (o Option[T]) UnwrapOrDefault() T {
 	return o.Some()
 }
 
+func (o Option[T]) ToPtr() *T {
+	if o.IsNone() {
+		return nil
+	}
+	return Ref(o.Some())
+}
+
+func (o Option[T]) ToZero() T {
+	return o.UnwrapOrDefault()
+}
+
+func (o Option[T]) String() string {
+	if o.IsNone() {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.Some())
+}
+
+func (o Option[T]) GoString() string {
+	if o.IsNone() {
+		return fmt.Sprintf("gutils.None[%s]()", typeName[T]())
+	}
+	return fmt.Sprintf("gutils.Some[%s](%#v)", typeName[T](), o.Some())
+}
+
+// Format passes the verb and flags through to the inner value,
+// so %+v, %x or %q apply to it rather than to the Option itself.
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, o.GoString())
+		return
+	}
+	if o.IsNone() {
+		_, _ = io.WriteString(f, "None")
+		return
+	}
+	_, _ = fmt.Fprintf(f, "Some(%s)", fmt.Sprintf(fmt.FormatString(f, verb), o.Some()))
+}
+
+func (o Option[T]) LogValue() slog.Value {
+	if o.IsNone() {
+		return slog.GroupValue(slog.Bool("some", false))
+	}
+	return slog.GroupValue(slog.Bool("some", true), slog.Any("value", o.Some()))
+}
+
 func (o Option[T]) MarshalJSON() ([]byte, error) {
 	if o.IsNone() {
 		return []byte("null"), nil
@@ -206,25 +346,67 @@ func (o Option[T]) MarshalJSON() ([]byte, error) {
 
 func (o *Option[T]) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		o.none = true
+		*o = None[T]()
 		return nil
 	}
 
-	err := json.Unmarshal(data, &o.some)
+	var t T
+	err := json.Unmarshal(data, &t)
 	if err != nil {
 		return err
 	}
 
-	o.none = false
+	o.Insert(t)
 	return nil
 }
 
 func Some[T any](t T) (o Option[T]) {
 	o.some = t
+	o.valid = true
 	return
 }
 
 func None[T any]() (o Option[T]) {
-	o.none = true
 	return
 }
+
+func FromPtr[T any](t *T) Option[T] {
+	if t == nil {
+		return None[T]()
+	}
+	return Some(*t)
+}
+
+func FromZero[T comparable](t T) Option[T] {
+	if IsZero(t) {
+		return None[T]()
+	}
+	return Some(t)
+}
+
+func FromOk[T any](t T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(t)
+}
+
+// FromNillable returns None if t is nil, including typed nil pointers,
+// maps, slices, channels, functions and interfaces.
+func FromNillable[T any](t T) Option[T] {
+	v := reflect.ValueOf(&t).Elem()
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return None[T]()
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		if v.IsNil() {
+			return None[T]()
+		}
+	}
+	return Some(t)
+}
@@ -1,8 +1,13 @@
 package gutils
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
 	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -197,7 +202,7 @@ func TestNil(t *testing.T) {
 	tests := []testCase[int]{
 		{
 			name:  "NilTest1",
-			wantO: Option[int]{none: true},
+			wantO: Option[int]{},
 		},
 	}
 	for _, tt := range tests {
@@ -355,23 +360,26 @@ func TestOption_GetOrInsert(t *testing.T) {
 		dv T
 	}
 	type testCase[T any] struct {
-		name string
-		o    Option[T]
-		args args[T]
-		want T
+		name  string
+		o     Option[T]
+		args  args[T]
+		want  T
+		wantO Option[T]
 	}
 	tests := []testCase[int]{
 		{
-			name: "Option_GetOrInsertTest1",
-			o:    Some(2),
-			args: args[int]{3},
-			want: 2,
+			name:  "Option_GetOrInsertTest1",
+			o:     Some(2),
+			args:  args[int]{3},
+			want:  2,
+			wantO: Some(2),
 		},
 		{
-			name: "Option_GetOrInsertTest2",
-			o:    None[int](),
-			args: args[int]{3},
-			want: 3,
+			name:  "Option_GetOrInsertTest2",
+			o:     None[int](),
+			args:  args[int]{3},
+			want:  3,
+			wantO: Some(3),
 		},
 	}
 	for _, tt := range tests {
@@ -379,6 +387,9 @@ func TestOption_GetOrInsert(t *testing.T) {
 			if got := tt.o.GetOrInsert(tt.args.dv); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetOrInsert() = %v, want %v", got, tt.want)
 			}
+			if !reflect.DeepEqual(tt.o, tt.wantO) {
+				t.Errorf("GetOrInsert() o = %v, wantO %v", tt.o, tt.wantO)
+			}
 		})
 	}
 }
@@ -388,10 +399,11 @@ func TestOption_GetOrInsertWith(t *testing.T) {
 		f func() T
 	}
 	type testCase[T any] struct {
-		name string
-		o    Option[T]
-		args args[T]
-		want T
+		name  string
+		o     Option[T]
+		args  args[T]
+		want  T
+		wantO Option[T]
 	}
 	tests := []testCase[int]{
 		{
@@ -400,7 +412,8 @@ func TestOption_GetOrInsertWith(t *testing.T) {
 			args: args[int]{func() int {
 				return 3
 			}},
-			want: 2,
+			want:  2,
+			wantO: Some(2),
 		},
 		{
 			name: "Option_GetOrInsertWithTest2",
@@ -408,7 +421,8 @@ func TestOption_GetOrInsertWith(t *testing.T) {
 			args: args[int]{func() int {
 				return 3
 			}},
-			want: 3,
+			want:  3,
+			wantO: Some(3),
 		},
 	}
 	for _, tt := range tests {
@@ -416,10 +430,179 @@ func TestOption_GetOrInsertWith(t *testing.T) {
 			if got := tt.o.GetOrInsertWith(tt.args.f); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetOrInsertWith() = %v, want %v", got, tt.want)
 			}
+			if !reflect.DeepEqual(tt.o, tt.wantO) {
+				t.Errorf("GetOrInsertWith() o = %v, wantO %v", tt.o, tt.wantO)
+			}
 		})
 	}
 }
 
+func TestOption_Insert(t *testing.T) {
+	type testCase[T any] struct {
+		name  string
+		o     Option[T]
+		t     T
+		want  T
+		wantO Option[T]
+	}
+	tests := []testCase[int]{
+		{
+			name:  "Option_InsertTest1",
+			o:     Some(2),
+			t:     3,
+			want:  3,
+			wantO: Some(3),
+		},
+		{
+			name:  "Option_InsertTest2",
+			o:     None[int](),
+			t:     3,
+			want:  3,
+			wantO: Some(3),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Insert(tt.t); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Insert() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.o, tt.wantO) {
+				t.Errorf("Insert() o = %v, wantO %v", tt.o, tt.wantO)
+			}
+		})
+	}
+}
+
+func TestOption_Take(t *testing.T) {
+	type testCase[T any] struct {
+		name  string
+		o     Option[T]
+		want  Option[T]
+		wantO Option[T]
+	}
+	tests := []testCase[int]{
+		{
+			name:  "Option_TakeTest1",
+			o:     Some(2),
+			want:  Some(2),
+			wantO: None[int](),
+		},
+		{
+			name:  "Option_TakeTest2",
+			o:     None[int](),
+			want:  None[int](),
+			wantO: None[int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Take(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Take() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.o, tt.wantO) {
+				t.Errorf("Take() o = %v, wantO %v", tt.o, tt.wantO)
+			}
+		})
+	}
+}
+
+func TestOption_TakeIf(t *testing.T) {
+	type testCase[T any] struct {
+		name  string
+		o     Option[T]
+		f     func(t *T) bool
+		want  Option[T]
+		wantO Option[T]
+	}
+	tests := []testCase[int]{
+		{
+			name:  "Option_TakeIfTest1",
+			o:     Some(2),
+			f:     func(t *int) bool { return *t%2 == 0 },
+			want:  Some(2),
+			wantO: None[int](),
+		},
+		{
+			name: "Option_TakeIfTest2",
+			o:    Some(2),
+			f: func(t *int) bool {
+				*t++
+				return false
+			},
+			want:  None[int](),
+			wantO: Some(3),
+		},
+		{
+			name:  "Option_TakeIfTest3",
+			o:     None[int](),
+			f:     func(t *int) bool { return true },
+			want:  None[int](),
+			wantO: None[int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.TakeIf(tt.f); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TakeIf() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.o, tt.wantO) {
+				t.Errorf("TakeIf() o = %v, wantO %v", tt.o, tt.wantO)
+			}
+		})
+	}
+}
+
+func TestOption_Replace(t *testing.T) {
+	type testCase[T any] struct {
+		name  string
+		o     Option[T]
+		t     T
+		want  Option[T]
+		wantO Option[T]
+	}
+	tests := []testCase[int]{
+		{
+			name:  "Option_ReplaceTest1",
+			o:     Some(2),
+			t:     3,
+			want:  Some(2),
+			wantO: Some(3),
+		},
+		{
+			name:  "Option_ReplaceTest2",
+			o:     None[int](),
+			t:     3,
+			want:  None[int](),
+			wantO: Some(3),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Replace(tt.t); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Replace() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.o, tt.wantO) {
+				t.Errorf("Replace() o = %v, wantO %v", tt.o, tt.wantO)
+			}
+		})
+	}
+}
+
+func TestOption_AsPtr(t *testing.T) {
+	o := Some(2)
+	if p := o.AsPtr(); p != nil {
+		*p = 3
+	}
+	if !reflect.DeepEqual(o, Some(3)) {
+		t.Errorf("AsPtr() o = %v, want %v", o, Some(3))
+	}
+
+	n := None[int]()
+	if p := n.AsPtr(); p != nil {
+		t.Errorf("AsPtr() = %v, want nil", p)
+	}
+}
+
 func TestOption_IsNone(t *testing.T) {
 	type testCase[T any] struct {
 		name string
@@ -927,7 +1110,7 @@ func TestSome(t *testing.T) {
 		{
 			name:  "SomeTest1",
 			args:  args[int]{t: 2},
-			wantO: Option[int]{some: 2},
+			wantO: Option[int]{some: 2, valid: true},
 		},
 	}
 	for _, tt := range tests {
@@ -1072,14 +1255,29 @@ func TestOption_UnmarshalJSON(t *testing.T) {
 			wantNone: false,
 			wantErr:  false,
 		},
+		{
+			name:     "Option_UnmarshalJSONTest3",
+			o:        Some(3),
+			args:     args{[]byte("null")},
+			wantNone: true,
+			wantErr:  false,
+		},
+		{
+			name:     "Option_UnmarshalJSONTest4",
+			o:        Some(3),
+			args:     args{[]byte(`"foo"`)},
+			wantSome: 3,
+			wantNone: false,
+			wantErr:  true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if err := tt.o.UnmarshalJSON(tt.args.data); (err != nil) != tt.wantErr {
 				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
 			}
-			if tt.o.none != tt.wantNone {
-				t.Errorf("UnmarshalJSON() none = %v, wantNone %v", tt.o.none, tt.wantNone)
+			if tt.o.IsNone() != tt.wantNone {
+				t.Errorf("UnmarshalJSON() none = %v, wantNone %v", tt.o.IsNone(), tt.wantNone)
 			}
 			if !tt.wantNone && !reflect.DeepEqual(tt.o.Some(), tt.wantSome) {
 				t.Errorf("UnmarshalJSON() got = %v, wantSome %v", tt.o.Some(), tt.wantSome)
@@ -1087,3 +1285,368 @@ func TestOption_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestOption_Zero(t *testing.T) {
+	var o Option[int]
+	if !o.IsNone() {
+		t.Errorf("IsNone() = %v, want %v", o.IsNone(), true)
+	}
+	if !reflect.DeepEqual(o, None[int]()) {
+		t.Errorf("Option[int]{} = %v, want %v", o, None[int]())
+	}
+	if got := o.Map(func(t int) int { return t + 1 }); got.IsSome() {
+		t.Errorf("Map() = %v, want %v", got, None[int]())
+	}
+	if got, _ := o.MarshalJSON(); string(got) != "null" {
+		t.Errorf("MarshalJSON() = %s, want %s", got, "null")
+	}
+}
+
+func TestOption_UnmarshalJSONStruct(t *testing.T) {
+	type user struct {
+		Name  Option[string] `json:"name"`
+		Age   Option[int]    `json:"age"`
+		Email Option[string] `json:"email"`
+	}
+	tests := []struct {
+		name string
+		data string
+		want user
+	}{
+		{
+			name: "Option_UnmarshalJSONStructTest1",
+			data: `{"name":"foo"}`,
+			want: user{Name: Some("foo")},
+		},
+		{
+			name: "Option_UnmarshalJSONStructTest2",
+			data: `{"name":"foo","age":0,"email":null}`,
+			want: user{Name: Some("foo"), Age: Some(0)},
+		},
+		{
+			name: "Option_UnmarshalJSONStructTest3",
+			data: `{}`,
+			want: user{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got user
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unmarshal() = %+v, want %+v", got, tt.want)
+			}
+			if got.Email.IsSome() {
+				t.Errorf("Email.IsSome() = %v, want %v", got.Email.IsSome(), false)
+			}
+		})
+	}
+}
+
+func TestFromPtr(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		t    *T
+		want Option[T]
+	}
+	tests := []testCase[int]{
+		{
+			name: "FromPtrTest1",
+			t:    Ref(2),
+			want: Some(2),
+		},
+		{
+			name: "FromPtrTest2",
+			t:    nil,
+			want: None[int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromPtr(tt.t); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromPtr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOption_ToPtr(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		o    Option[T]
+		want *T
+	}
+	tests := []testCase[int]{
+		{
+			name: "Option_ToPtrTest1",
+			o:    Some(2),
+			want: Ref(2),
+		},
+		{
+			name: "Option_ToPtrTest2",
+			o:    None[int](),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.ToPtr(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToPtr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromZero(t *testing.T) {
+	type testCase[T comparable] struct {
+		name string
+		t    T
+		want Option[T]
+	}
+	tests := []testCase[string]{
+		{
+			name: "FromZeroTest1",
+			t:    "foo",
+			want: Some("foo"),
+		},
+		{
+			name: "FromZeroTest2",
+			t:    "",
+			want: None[string](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromZero(tt.t); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromZero() = %v, want %v", got, tt.want)
+			}
+			if got := FromZero(tt.t).ToZero(); got != tt.t {
+				t.Errorf("ToZero() = %v, want %v", got, tt.t)
+			}
+		})
+	}
+}
+
+func TestFromOk(t *testing.T) {
+	m := map[string]int{"a": 1}
+	v, ok := m["a"]
+	if got := FromOk(v, ok); !reflect.DeepEqual(got, Some(1)) {
+		t.Errorf("FromOk() = %v, want %v", got, Some(1))
+	}
+	v, ok = m["b"]
+	if got := FromOk(v, ok); !reflect.DeepEqual(got, None[int]()) {
+		t.Errorf("FromOk() = %v, want %v", got, None[int]())
+	}
+
+	var i any = "foo"
+	s, ok := i.(string)
+	if got := FromOk(s, ok); !reflect.DeepEqual(got, Some("foo")) {
+		t.Errorf("FromOk() = %v, want %v", got, Some("foo"))
+	}
+	n, ok := i.(int)
+	if got := FromOk(n, ok); !reflect.DeepEqual(got, None[int]()) {
+		t.Errorf("FromOk() = %v, want %v", got, None[int]())
+	}
+}
+
+func TestFromNillable(t *testing.T) {
+	var nilErr *json.SyntaxError
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{name: "FromNillableTest1", got: FromNillable[error](nil).IsSome(), want: false},
+		{name: "FromNillableTest2", got: FromNillable[error](errors.New("foo")).IsSome(), want: true},
+		{name: "FromNillableTest3", got: FromNillable[error](nilErr).IsSome(), want: false},
+		{name: "FromNillableTest4", got: FromNillable[map[string]int](nil).IsSome(), want: false},
+		{name: "FromNillableTest5", got: FromNillable(map[string]int{}).IsSome(), want: true},
+		{name: "FromNillableTest6", got: FromNillable[[]int](nil).IsSome(), want: false},
+		{name: "FromNillableTest7", got: FromNillable(0).IsSome(), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("FromNillable().IsSome() = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOption_Format(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+	tests := []struct {
+		name   string
+		format string
+		o      any
+		want   string
+	}{
+		{name: "Option_FormatTest1", format: "%v", o: Some(42), want: "Some(42)"},
+		{name: "Option_FormatTest2", format: "%v", o: None[int](), want: "None"},
+		{name: "Option_FormatTest3", format: "%s", o: Some("foo"), want: "Some(foo)"},
+		{name: "Option_FormatTest4", format: "%q", o: Some("foo"), want: `Some("foo")`},
+		{name: "Option_FormatTest5", format: "%+v", o: Some(point{1, 2}), want: "Some({X:1 Y:2})"},
+		{name: "Option_FormatTest6", format: "%x", o: Some(255), want: "Some(ff)"},
+		{name: "Option_FormatTest7", format: "%#v", o: Some(42), want: "gutils.Some[int](42)"},
+		{name: "Option_FormatTest8", format: "%#v", o: None[string](), want: "gutils.None[string]()"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.o); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+
+	if got := Some(42).String(); got != "Some(42)" {
+		t.Errorf("String() = %v, want %v", got, "Some(42)")
+	}
+}
+
+func TestOption_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key != "a" && a.Key != "b" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("", "a", Some(42), "b", None[int]())
+
+	want := `{"a":{"some":true,"value":42},"b":{"some":false}}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("LogValue() = %v, want %v", got, want)
+	}
+}
+
+func TestMatchO(t *testing.T) {
+	type testCase[T, U any] struct {
+		name string
+		o    Option[T]
+		want U
+	}
+	tests := []testCase[int, string]{
+		{name: "MatchOTest1", o: Some(3), want: "3"},
+		{name: "MatchOTest2", o: None[int](), want: "none"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchO(tt.o, strconv.Itoa, func() string { return "none" }); got != tt.want {
+				t.Errorf("MatchO() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOption_Filter(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		o    Option[T]
+		want Option[T]
+	}
+	even := func(t int) bool { return t%2 == 0 }
+	tests := []testCase[int]{
+		{name: "FilterTest1", o: Some(2), want: Some(2)},
+		{name: "FilterTest2", o: Some(3), want: None[int]()},
+		{name: "FilterTest3", o: None[int](), want: None[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Filter(even); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOption_Inspect(t *testing.T) {
+	var seen []string
+	got := Some(1).
+		Inspect(func(t int) { seen = append(seen, "inspect "+strconv.Itoa(t)) }).
+		Tap(func(o Option[int]) { seen = append(seen, "tap "+o.String()) }).
+		Filter(func(t int) bool { return t > 1 }).
+		Inspect(func(t int) { seen = append(seen, "unreachable") }).
+		Tap(func(o Option[int]) { seen = append(seen, "tap "+o.String()) })
+
+	if !reflect.DeepEqual(got, None[int]()) {
+		t.Errorf("Inspect() = %v, want %v", got, None[int]())
+	}
+	if want := []string{"inspect 1", "tap Some(1)", "tap None"}; !reflect.DeepEqual(seen, want) {
+		t.Errorf("seen = %v, want %v", seen, want)
+	}
+}
+
+func TestFlattenO(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		o    Option[Option[T]]
+		want Option[T]
+	}
+	tests := []testCase[int]{
+		{name: "FlattenOTest1", o: Some(Some(1)), want: Some(1)},
+		{name: "FlattenOTest2", o: Some(None[int]()), want: None[int]()},
+		{name: "FlattenOTest3", o: None[Option[int]](), want: None[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlattenO(tt.o); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlattenO() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransposeO(t *testing.T) {
+	err := errors.New("error")
+	type testCase[T any] struct {
+		name string
+		o    Option[Result[T]]
+		want Result[Option[T]]
+	}
+	tests := []testCase[int]{
+		{name: "TransposeOTest1", o: Some(Ok(1)), want: Ok(Some(1))},
+		{name: "TransposeOTest2", o: Some(Err[int](err)), want: Err[Option[int]](err)},
+		{name: "TransposeOTest3", o: None[Result[int]](), want: Ok(None[int]())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TransposeO(tt.o)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TransposeO() = %v, want %v", got, tt.want)
+			}
+			if back := TransposeR(got); !reflect.DeepEqual(back, tt.o) {
+				t.Errorf("TransposeR() = %v, want %v", back, tt.o)
+			}
+		})
+	}
+}
+
+func TestEqualO(t *testing.T) {
+	type testCase[T comparable] struct {
+		name string
+		a, b Option[T]
+		want bool
+	}
+	tests := []testCase[int]{
+		{name: "EqualOTest1", a: Some(1), b: Some(1), want: true},
+		{name: "EqualOTest2", a: Some(1), b: Some(2), want: false},
+		{name: "EqualOTest3", a: Some(0), b: None[int](), want: false},
+		{name: "EqualOTest4", a: None[int](), b: None[int](), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualO(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualO() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if !ContainsO(Some(1), 1) || ContainsO(Some(1), 2) || ContainsO(None[int](), 0) {
+		t.Errorf("ContainsO() mismatch")
+	}
+}
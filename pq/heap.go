@@ -0,0 +1,96 @@
+package pq
+
+import (
+	"github.com/Yuukirn/gutils"
+	"golang.org/x/exp/constraints"
+)
+
+// Heap is a binary heap whose top is the least element according to less.
+type Heap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func New[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// NewOrdered returns a min-heap of ordered values.
+func NewOrdered[T constraints.Ordered]() *Heap[T] {
+	return New(func(a, b T) bool { return a < b })
+}
+
+// FromSlice builds a heap from the elements of s in linear time.
+func FromSlice[T any](s []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{items: make([]T, len(s)), less: less}
+	copy(h.items, s)
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+func (h *Heap[T]) Push(ts ...T) {
+	for _, t := range ts {
+		h.items = append(h.items, t)
+		h.up(len(h.items) - 1)
+	}
+}
+
+func (h *Heap[T]) Pop() gutils.Option[T] {
+	if len(h.items) == 0 {
+		return gutils.None[T]()
+	}
+
+	top := h.items[0]
+	last := len(h.items) - 1
+	h.items[0] = h.items[last]
+	h.items[last] = gutils.Zero[T]()
+	h.items = h.items[:last]
+	h.down(0)
+	return gutils.Some(top)
+}
+
+func (h *Heap[T]) Peek() gutils.Option[T] {
+	if len(h.items) == 0 {
+		return gutils.None[T]()
+	}
+	return gutils.Some(h.items[0])
+}
+
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		p := (i - 1) / 2
+		if !h.less(h.items[i], h.items[p]) {
+			return
+		}
+		h.items[i], h.items[p] = h.items[p], h.items[i]
+		i = p
+	}
+}
+
+func (h *Heap[T]) down(i int) {
+	n := len(h.items)
+	for {
+		m := i
+		if l := 2*i + 1; l < n && h.less(h.items[l], h.items[m]) {
+			m = l
+		}
+		if r := 2*i + 2; r < n && h.less(h.items[r], h.items[m]) {
+			m = r
+		}
+		if m == i {
+			return
+		}
+		h.items[i], h.items[m] = h.items[m], h.items[i]
+		i = m
+	}
+}
@@ -0,0 +1,139 @@
+package pq
+
+import "github.com/Yuukirn/gutils"
+
+// Handle refers to an element of an IndexedHeap so its priority can be changed
+// or the element removed. A handle is invalid once its element left the heap.
+type Handle[T, P any] struct {
+	value    T
+	priority P
+	index    int
+}
+
+func (h *Handle[T, P]) Value() T {
+	return h.value
+}
+
+func (h *Handle[T, P]) Priority() P {
+	return h.priority
+}
+
+// IndexedHeap is a heap of values ordered by a separate priority, with the
+// least priority according to less on top. Priorities can be changed in place,
+// as needed by algorithms such as Dijkstra's.
+type IndexedHeap[T, P any] struct {
+	items []*Handle[T, P]
+	less  func(a, b P) bool
+}
+
+func NewIndexed[T, P any](less func(a, b P) bool) *IndexedHeap[T, P] {
+	return &IndexedHeap[T, P]{less: less}
+}
+
+func (h *IndexedHeap[T, P]) Push(t T, p P) *Handle[T, P] {
+	e := &Handle[T, P]{value: t, priority: p, index: len(h.items)}
+	h.items = append(h.items, e)
+	h.up(e.index)
+	return e
+}
+
+func (h *IndexedHeap[T, P]) Pop() gutils.Option[T] {
+	if len(h.items) == 0 {
+		return gutils.None[T]()
+	}
+	return gutils.Some(h.remove(0).value)
+}
+
+func (h *IndexedHeap[T, P]) Peek() gutils.Option[T] {
+	if len(h.items) == 0 {
+		return gutils.None[T]()
+	}
+	return gutils.Some(h.items[0].value)
+}
+
+// Update changes the priority of the element referred to by e.
+// It returns false if e is no longer in the heap.
+func (h *IndexedHeap[T, P]) Update(e *Handle[T, P], p P) bool {
+	if !h.contains(e) {
+		return false
+	}
+	e.priority = p
+	h.fix(e.index)
+	return true
+}
+
+// Remove removes the element referred to by e, or returns None if it is no longer in the heap.
+func (h *IndexedHeap[T, P]) Remove(e *Handle[T, P]) gutils.Option[T] {
+	if !h.contains(e) {
+		return gutils.None[T]()
+	}
+	return gutils.Some(h.remove(e.index).value)
+}
+
+func (h *IndexedHeap[T, P]) Len() int {
+	return len(h.items)
+}
+
+func (h *IndexedHeap[T, P]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+func (h *IndexedHeap[T, P]) contains(e *Handle[T, P]) bool {
+	return e != nil && e.index >= 0 && e.index < len(h.items) && h.items[e.index] == e
+}
+
+func (h *IndexedHeap[T, P]) remove(i int) *Handle[T, P] {
+	e := h.items[i]
+	last := len(h.items) - 1
+	if i != last {
+		h.swap(i, last)
+	}
+	h.items[last] = nil
+	h.items = h.items[:last]
+	if i != last {
+		h.fix(i)
+	}
+	e.index = -1
+	return e
+}
+
+func (h *IndexedHeap[T, P]) fix(i int) {
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+func (h *IndexedHeap[T, P]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *IndexedHeap[T, P]) up(i int) {
+	for i > 0 {
+		p := (i - 1) / 2
+		if !h.less(h.items[i].priority, h.items[p].priority) {
+			return
+		}
+		h.swap(i, p)
+		i = p
+	}
+}
+
+func (h *IndexedHeap[T, P]) down(i int) bool {
+	n, start := len(h.items), i
+	for {
+		m := i
+		if l := 2*i + 1; l < n && h.less(h.items[l].priority, h.items[m].priority) {
+			m = l
+		}
+		if r := 2*i + 2; r < n && h.less(h.items[r].priority, h.items[m].priority) {
+			m = r
+		}
+		if m == i {
+			return i > start
+		}
+		h.swap(i, m)
+		i = m
+	}
+}
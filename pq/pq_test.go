@@ -0,0 +1,173 @@
+package pq
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Yuukirn/gutils"
+)
+
+func drain[T any](h *Heap[T]) []T {
+	var res []T
+	for o := h.Pop(); o.IsSome(); o = h.Pop() {
+		res = append(res, o.Unwrap())
+	}
+	return res
+}
+
+func TestHeap_Pop(t *testing.T) {
+	type testCase struct {
+		name string
+		h    *Heap[int]
+		want []int
+	}
+	tests := []testCase{
+		{
+			name: "Heap_PopTest1",
+			h:    NewOrdered[int](),
+			want: nil,
+		},
+		{
+			name: "Heap_PopTest2",
+			h: func() *Heap[int] {
+				h := NewOrdered[int]()
+				h.Push(5, 1, 4, 2, 3, 1)
+				return h
+			}(),
+			want: []int{1, 1, 2, 3, 4, 5},
+		},
+		{
+			name: "Heap_PopTest3",
+			h:    FromSlice([]int{5, 1, 4, 2, 3}, func(a, b int) bool { return a > b }),
+			want: []int{5, 4, 3, 2, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.Peek(); !reflect.DeepEqual(got.IsSome(), tt.want != nil) {
+				t.Errorf("Peek() = %v, want some %v", got, tt.want != nil)
+			}
+			if got := drain(tt.h); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pop() = %v, want %v", got, tt.want)
+			}
+			if got := tt.h.Pop(); got.IsSome() {
+				t.Errorf("Pop() = %v, want %v", got, gutils.None[int]())
+			}
+		})
+	}
+}
+
+func TestHeap_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := r.Perm(1000)
+	h := NewOrdered[int]()
+	h.Push(s...)
+
+	want := append([]int(nil), s...)
+	sort.Ints(want)
+	if got := drain(h); !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() order is not sorted")
+	}
+}
+
+func TestIndexedHeap(t *testing.T) {
+	h := NewIndexed[string](func(a, b int) bool { return a < b })
+	a := h.Push("a", 5)
+	b := h.Push("b", 3)
+	c := h.Push("c", 4)
+	h.Push("d", 6)
+
+	if got := h.Peek(); !reflect.DeepEqual(got, gutils.Some("b")) {
+		t.Errorf("Peek() = %v, want %v", got, gutils.Some("b"))
+	}
+	if !h.Update(a, 1) {
+		t.Errorf("Update() = false, want true")
+	}
+	if got := h.Peek(); !reflect.DeepEqual(got, gutils.Some("a")) {
+		t.Errorf("Peek() = %v, want %v", got, gutils.Some("a"))
+	}
+	h.Update(b, 10)
+	if got := h.Remove(c); !reflect.DeepEqual(got, gutils.Some("c")) {
+		t.Errorf("Remove() = %v, want %v", got, gutils.Some("c"))
+	}
+	if got := h.Remove(c); got.IsSome() {
+		t.Errorf("Remove() = %v, want %v", got, gutils.None[string]())
+	}
+	if h.Update(c, 0) {
+		t.Errorf("Update() = true, want false")
+	}
+
+	var got []string
+	for o := h.Pop(); o.IsSome(); o = h.Pop() {
+		got = append(got, o.Unwrap())
+	}
+	if want := []string{"a", "d", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() = %v, want %v", got, want)
+	}
+	if a.Value() != "a" || a.Priority() != 1 {
+		t.Errorf("Handle = (%v, %v), want (%v, %v)", a.Value(), a.Priority(), "a", 1)
+	}
+}
+
+func TestIndexedHeap_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := NewIndexed[int](func(a, b int) bool { return a < b })
+	handles := map[int]*Handle[int, int]{}
+	prio := map[int]int{}
+	for i := 0; i < 500; i++ {
+		p := r.Intn(1000)
+		handles[i] = h.Push(i, p)
+		prio[i] = p
+	}
+	for i := 0; i < 500; i++ {
+		k := r.Intn(500)
+		if r.Intn(4) == 0 {
+			if h.Remove(handles[k]).IsSome() {
+				delete(prio, k)
+			}
+			continue
+		}
+		p := r.Intn(1000)
+		if h.Update(handles[k], p) {
+			prio[k] = p
+		}
+	}
+
+	last := -1
+	for o := h.Pop(); o.IsSome(); o = h.Pop() {
+		p, exist := prio[o.Unwrap()]
+		if !exist || p < last {
+			t.Fatalf("Pop() = %v with priority %v after %v", o.Unwrap(), p, last)
+		}
+		delete(prio, o.Unwrap())
+		last = p
+	}
+	if len(prio) != 0 {
+		t.Errorf("%v elements were not popped", len(prio))
+	}
+}
+
+func TestTopK(t *testing.T) {
+	type testCase struct {
+		name string
+		k    int
+		s    []int
+		want []int
+	}
+	tests := []testCase{
+		{name: "TopKTest1", k: 3, s: []int{5, 1, 9, 3, 7, 2}, want: []int{9, 7, 5}},
+		{name: "TopKTest2", k: 3, s: []int{5, 1}, want: []int{5, 1}},
+		{name: "TopKTest3", k: 0, s: []int{5, 1}, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tk := NewTopK(tt.k, func(a, b int) bool { return a < b })
+			tk.Add(tt.s...)
+			if got := tk.Result(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Result() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package pq
+
+// TopK keeps the k greatest elements according to less out of all the elements added to it.
+type TopK[T any] struct {
+	k    int
+	heap *Heap[T]
+}
+
+func NewTopK[T any](k int, less func(a, b T) bool) *TopK[T] {
+	return &TopK[T]{k: k, heap: New(less)}
+}
+
+func (t *TopK[T]) Add(ts ...T) {
+	if t.k <= 0 {
+		return
+	}
+	for _, e := range ts {
+		if t.heap.Len() < t.k {
+			t.heap.Push(e)
+			continue
+		}
+		if t.heap.less(t.heap.items[0], e) {
+			t.heap.items[0] = e
+			t.heap.down(0)
+		}
+	}
+}
+
+func (t *TopK[T]) Len() int {
+	return t.heap.Len()
+}
+
+// Result returns the collected elements from greatest to least.
+func (t *TopK[T]) Result() []T {
+	h := FromSlice(t.heap.items, t.heap.less)
+	var res = make([]T, h.Len())
+	for i := len(res) - 1; i >= 0; i-- {
+		res[i] = h.Pop().Unwrap()
+	}
+	return res
+}
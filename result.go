@@ -1,5 +1,13 @@
 package gutils
 
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+)
+
 type Result[T any] struct {
 	ok  T
 	err error
@@ -83,6 +91,100 @@ func (r *Result[T]) MapErr(f func(err error) error) Result[T] {
 	return *r
 }
 
+func MatchR[T, U any](r Result[T], onOk func(t T) U, onErr func(err error) U) U {
+	if r.IsErr() {
+		return onErr(r.err)
+	}
+	return onOk(r.ok)
+}
+
+// Inspect calls f with the value of r if it is Ok, and returns r.
+func (r *Result[T]) Inspect(f func(t T)) Result[T] {
+	if r.IsOk() {
+		f(r.ok)
+	}
+	return *r
+}
+
+// InspectErr calls f with the error of r if it is Err, and returns r.
+func (r *Result[T]) InspectErr(f func(err error)) Result[T] {
+	if r.IsErr() {
+		f(r.err)
+	}
+	return *r
+}
+
+// Tap calls f with r, and returns r.
+func (r *Result[T]) Tap(f func(r Result[T])) Result[T] {
+	f(*r)
+	return *r
+}
+
+func FlattenR[T any](r Result[Result[T]]) Result[T] {
+	if r.IsErr() {
+		return Err[T](r.err)
+	}
+	return r.ok
+}
+
+// TransposeR turns Ok(None) into None, and any other r into Some with its value unwrapped from Some.
+func TransposeR[T any](r Result[Option[T]]) Option[Result[T]] {
+	if r.IsErr() {
+		return Some(Err[T](r.err))
+	}
+	if r.ok.IsNone() {
+		return None[Result[T]]()
+	}
+	return Some(Ok(r.ok.Some()))
+}
+
+func ContainsR[T comparable](r Result[T], t T) bool {
+	return r.IsOk() && r.ok == t
+}
+
+// EqualR reports whether a and b are both Ok with equal values, or both Err with
+// equal errors. Errors of types that == cannot compare, such as slices, are
+// compared with reflect.DeepEqual instead.
+func EqualR[T comparable](a, b Result[T]) bool {
+	if a.IsErr() || b.IsErr() {
+		if a.IsOk() || b.IsOk() {
+			return false
+		}
+		if !reflect.TypeOf(a.err).Comparable() || !reflect.TypeOf(b.err).Comparable() {
+			return reflect.DeepEqual(a.err, b.err)
+		}
+		return a.err == b.err
+	}
+	return a.ok == b.ok
+}
+
+func (r *Result[T]) Context(msg string) Result[T] {
+	if r.IsErr() {
+		return Err[T](wrap(r.err, msg))
+	}
+	return *r
+}
+
+// Wrapf is Context with a message formatted by fmt.Errorf. Errors passed
+// through %w stay in the chain next to the original error.
+func (r *Result[T]) Wrapf(format string, args ...any) Result[T] {
+	if r.IsErr() {
+		return Err[T](wrapf(r.err, format, args...))
+	}
+	return *r
+}
+
+func (r *Result[T]) WithField(k string, v any) Result[T] {
+	if r.IsErr() {
+		return Err[T](&FieldError{Key: k, Value: v, Err: r.err})
+	}
+	return *r
+}
+
+func (r *Result[T]) IsErrIs(target error) bool {
+	return r.IsErr() && errors.Is(r.err, target)
+}
+
 func (r *Result[T]) And(res Result[T]) Result[T] {
 	if r.IsErr() {
 		return *r
@@ -167,6 +269,41 @@ func (r *Result[T]) ExpectErr(msg string) error {
 	panic(msg)
 }
 
+func (r Result[T]) String() string {
+	if r.IsErr() {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.ok)
+}
+
+func (r Result[T]) GoString() string {
+	if r.IsErr() {
+		return fmt.Sprintf("gutils.Err[%s](%#v)", typeName[T](), r.err)
+	}
+	return fmt.Sprintf("gutils.Ok[%s](%#v)", typeName[T](), r.ok)
+}
+
+// Format passes the verb and flags through to the inner value or error,
+// so %+v on an Err prints the error's detailed form.
+func (r Result[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, r.GoString())
+		return
+	}
+	if r.IsErr() {
+		_, _ = fmt.Fprintf(f, "Err(%s)", fmt.Sprintf(fmt.FormatString(f, verb), r.err))
+		return
+	}
+	_, _ = fmt.Fprintf(f, "Ok(%s)", fmt.Sprintf(fmt.FormatString(f, verb), r.ok))
+}
+
+func (r Result[T]) LogValue() slog.Value {
+	if r.IsErr() {
+		return slog.GroupValue(slog.Bool("ok", false), slog.String("error", r.err.Error()))
+	}
+	return slog.GroupValue(slog.Bool("ok", true), slog.Any("value", r.ok))
+}
+
 func Ok[T any](t T) (r Result[T]) {
 	r.ok = t
 	return
@@ -1,9 +1,19 @@
 package gutils
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
 	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/Yuukirn/gutils/errs"
 )
 
 func TestAndR(t *testing.T) {
@@ -846,3 +856,287 @@ func TestResult_UnwrapOrElse(t *testing.T) {
 		})
 	}
 }
+
+type detailedErr struct{}
+
+func (detailedErr) Error() string {
+	return "error"
+}
+
+func (e detailedErr) Format(f fmt.State, verb rune) {
+	if f.Flag('+') {
+		_, _ = io.WriteString(f, "error: details")
+		return
+	}
+	_, _ = io.WriteString(f, e.Error())
+}
+
+func TestResult_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		r      any
+		want   string
+	}{
+		{name: "Result_FormatTest1", format: "%v", r: Ok(42), want: "Ok(42)"},
+		{name: "Result_FormatTest2", format: "%v", r: Err[int](errors.New("error")), want: "Err(error)"},
+		{name: "Result_FormatTest3", format: "%q", r: Ok("foo"), want: `Ok("foo")`},
+		{name: "Result_FormatTest4", format: "%v", r: Err[int](detailedErr{}), want: "Err(error)"},
+		{name: "Result_FormatTest5", format: "%+v", r: Err[int](detailedErr{}), want: "Err(error: details)"},
+		{name: "Result_FormatTest6", format: "%#v", r: Ok(42), want: "gutils.Ok[int](42)"},
+		{name: "Result_FormatTest7", format: "%#v", r: Err[int](errors.New("error")), want: `gutils.Err[int](&errors.errorString{s:"error"})`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.r); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+
+	if got := Err[int](errors.New("error")).String(); got != "Err(error)" {
+		t.Errorf("String() = %v, want %v", got, "Err(error)")
+	}
+}
+
+func TestResult_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key != "a" && a.Key != "b" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("", "a", Ok(42), "b", Err[int](errors.New("error")))
+
+	want := `{"a":{"ok":true,"value":42},"b":{"ok":false,"error":"error"}}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("LogValue() = %v, want %v", got, want)
+	}
+}
+
+type codeErr struct {
+	code int
+}
+
+func (e *codeErr) Error() string {
+	return "code " + strconv.Itoa(e.code)
+}
+
+func TestResult_Context(t *testing.T) {
+	base := errors.New("not found")
+
+	r := Err[int](base)
+	r = r.Context("load user")
+	r = r.Wrapf("handle request %d", 7)
+	_, _, line, _ := runtime.Caller(0)
+
+	if got := r.ExpectErr("").Error(); got != "handle request 7: load user: not found" {
+		t.Errorf("Error() = %v, want %v", got, "handle request 7: load user: not found")
+	}
+	if !r.IsErrIs(base) {
+		t.Errorf("IsErrIs() = %v, want %v", false, true)
+	}
+
+	wantLoc := "result_test.go:" + strconv.Itoa(line-1)
+	we := ErrAs[*WrapError](r)
+	if we.IsNone() || we.Unwrap().Location() != wantLoc {
+		t.Errorf("Location() = %v, want %v", we, wantLoc)
+	}
+	want := fmt.Sprintf("handle request 7 (%s): load user (result_test.go:%d): not found", wantLoc, line-2)
+	if got := fmt.Sprintf("%+v", r.ExpectErr("")); got != want {
+		t.Errorf("Sprintf(%%+v) = %v, want %v", got, want)
+	}
+
+	ok := Ok(1)
+	if got := ok.Context("load user"); !reflect.DeepEqual(got, Ok(1)) {
+		t.Errorf("Context() = %v, want %v", got, Ok(1))
+	}
+	if ok.IsErrIs(base) {
+		t.Errorf("IsErrIs() = %v, want %v", true, false)
+	}
+}
+
+func TestResult_Wrapf(t *testing.T) {
+	base := errors.New("not found")
+	r := Err[int](base)
+	r = r.Wrapf("load %s: %w", "user", io.ErrUnexpectedEOF)
+
+	if got := r.ExpectErr("").Error(); got != "load user: unexpected EOF: not found" {
+		t.Errorf("Error() = %v, want %v", got, "load user: unexpected EOF: not found")
+	}
+	if !r.IsErrIs(base) || !r.IsErrIs(io.ErrUnexpectedEOF) {
+		t.Errorf("IsErrIs() = %v, want both errors in the chain", r)
+	}
+	if r.IsErrIs(io.EOF) {
+		t.Errorf("IsErrIs() = %v, want %v", true, false)
+	}
+}
+
+func TestResult_WithField(t *testing.T) {
+	r := Err[int](&codeErr{404})
+	r = r.WithField("user", 7)
+	r = r.Context("load user")
+	r = r.WithField("request", "abc")
+
+	if got := r.ExpectErr("").Error(); got != "load user: code 404 [user=7] [request=abc]" {
+		t.Errorf("Error() = %v, want %v", got, "load user: code 404 [user=7] [request=abc]")
+	}
+	if got := Fields(r.ExpectErr("")); !reflect.DeepEqual(got, map[string]any{"user": 7, "request": "abc"}) {
+		t.Errorf("Fields() = %v, want %v", got, map[string]any{"user": 7, "request": "abc"})
+	}
+	if got := ErrAs[*FieldError](r); got.IsNone() || got.Unwrap().Key != "request" {
+		t.Errorf("ErrAs() = %v, want key %v", got, "request")
+	}
+	if got := ErrAs[*codeErr](r); !reflect.DeepEqual(got, Some(&codeErr{404})) {
+		t.Errorf("ErrAs() = %v, want %v", got, Some(&codeErr{404}))
+	}
+	if got := ErrAs[*codeErr](Ok(1)); got.IsSome() {
+		t.Errorf("ErrAs() = %v, want %v", got, None[*codeErr]())
+	}
+}
+
+func TestErrAs(t *testing.T) {
+	pe := &fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist}
+	r := Err[int](fmt.Errorf("load: %w", pe))
+
+	if got := ErrAs[*fs.PathError](r); !reflect.DeepEqual(got, Some(pe)) {
+		t.Errorf("ErrAs() = %v, want %v", got, Some(pe))
+	}
+	if got := ErrAs[interface{ Code() int }](r); got.IsSome() {
+		t.Errorf("ErrAs() = %v, want None", got)
+	}
+	if got := ErrAs[*fs.PathError](Ok(1)); got.IsSome() {
+		t.Errorf("ErrAs() = %v, want None", got)
+	}
+	if got := ErrAs[int](r); got.IsSome() {
+		t.Errorf("ErrAs() = %v, want None", got)
+	}
+}
+
+func TestResult_Code(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		r    Result[T]
+		want errs.Code
+	}
+	tests := []testCase[int]{
+		{name: "CodeTest1", r: Ok(1), want: errs.OK},
+		{name: "CodeTest2", r: ErrNotFound[int]("user 1"), want: errs.NotFound},
+		{name: "CodeTest3", r: ErrConflict[int]("version"), want: errs.Conflict},
+		{name: "CodeTest4", r: Err[int](fmt.Errorf("parse: %w", errs.New(errs.InvalidArgument, "id"))), want: errs.InvalidArgument},
+		{name: "CodeTest5", r: Err[int](errors.New("error")), want: errs.Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Code(); got != tt.want {
+				t.Errorf("Code() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchR(t *testing.T) {
+	type testCase[T, U any] struct {
+		name string
+		r    Result[T]
+		want U
+	}
+	tests := []testCase[int, string]{
+		{name: "MatchRTest1", r: Ok(3), want: "3"},
+		{name: "MatchRTest2", r: Err[int](errors.New("error")), want: "error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchR(tt.r, strconv.Itoa, func(err error) string { return err.Error() }); got != tt.want {
+				t.Errorf("MatchR() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Inspect(t *testing.T) {
+	err := errors.New("error")
+	type testCase[T any] struct {
+		name string
+		r    Result[T]
+		want []string
+	}
+	tests := []testCase[int]{
+		{name: "InspectTest1", r: Ok(1), want: []string{"ok 1", "tap Ok(1)"}},
+		{name: "InspectTest2", r: Err[int](err), want: []string{"err error", "tap Err(error)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen []string
+			got := tt.r.Inspect(func(t int) { seen = append(seen, "ok "+strconv.Itoa(t)) })
+			got = got.InspectErr(func(err error) { seen = append(seen, "err "+err.Error()) })
+			got = got.Tap(func(r Result[int]) { seen = append(seen, "tap "+r.String()) })
+			if !reflect.DeepEqual(got, tt.r) {
+				t.Errorf("Inspect() = %v, want %v", got, tt.r)
+			}
+			if !reflect.DeepEqual(seen, tt.want) {
+				t.Errorf("seen = %v, want %v", seen, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenR(t *testing.T) {
+	err := errors.New("error")
+	type testCase[T any] struct {
+		name string
+		r    Result[Result[T]]
+		want Result[T]
+	}
+	tests := []testCase[int]{
+		{name: "FlattenRTest1", r: Ok(Ok(1)), want: Ok(1)},
+		{name: "FlattenRTest2", r: Ok(Err[int](err)), want: Err[int](err)},
+		{name: "FlattenRTest3", r: Err[Result[int]](err), want: Err[int](err)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlattenR(tt.r); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlattenR() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// sliceError is an error type that == cannot compare.
+type sliceError []string
+
+func (e sliceError) Error() string {
+	return strings.Join(e, "; ")
+}
+
+func TestEqualR(t *testing.T) {
+	err := errors.New("error")
+	type testCase[T comparable] struct {
+		name string
+		a, b Result[T]
+		want bool
+	}
+	tests := []testCase[int]{
+		{name: "EqualRTest1", a: Ok(1), b: Ok(1), want: true},
+		{name: "EqualRTest2", a: Ok(1), b: Ok(2), want: false},
+		{name: "EqualRTest3", a: Ok(0), b: Err[int](err), want: false},
+		{name: "EqualRTest4", a: Err[int](err), b: Err[int](err), want: true},
+		{name: "EqualRTest5", a: Err[int](err), b: Err[int](errors.New("error")), want: false},
+		{name: "EqualRTest6", a: Err[int](sliceError{"a"}), b: Err[int](sliceError{"a"}), want: true},
+		{name: "EqualRTest7", a: Err[int](sliceError{"a"}), b: Err[int](sliceError{"b"}), want: false},
+		{name: "EqualRTest8", a: Err[int](sliceError{"a"}), b: Err[int](err), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualR(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualR() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if !ContainsR(Ok(1), 1) || ContainsR(Ok(1), 2) || ContainsR(Err[int](err), 0) {
+		t.Errorf("ContainsR() mismatch")
+	}
+}
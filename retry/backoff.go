@@ -0,0 +1,62 @@
+package retry
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Backoff computes the delay before the next attempt. attempt is the number of
+// attempts made so far, starting at 1, and prev is the previous delay.
+type Backoff interface {
+	Next(attempt int, prev time.Duration) time.Duration
+}
+
+type BackoffFunc func(attempt int, prev time.Duration) time.Duration
+
+func (f BackoffFunc) Next(attempt int, prev time.Duration) time.Duration {
+	return f(attempt, prev)
+}
+
+func Constant(d time.Duration) Backoff {
+	return BackoffFunc(func(int, time.Duration) time.Duration {
+		return d
+	})
+}
+
+// Exponential waits base, base*factor, base*factor^2, ... capped at max.
+func Exponential(base, max time.Duration, factor float64) Backoff {
+	return BackoffFunc(func(attempt int, _ time.Duration) time.Duration {
+		d := float64(base) * math.Pow(factor, float64(attempt-1))
+		if d > float64(max) {
+			return max
+		}
+		return time.Duration(d)
+	})
+}
+
+// DecorrelatedJitter waits a random duration between base and three times the
+// previous delay, capped at max. A nil r uses a randomly seeded source.
+func DecorrelatedJitter(base, max time.Duration, r *rand.Rand) Backoff {
+	if r == nil {
+		r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	var mu sync.Mutex
+	return BackoffFunc(func(_ int, prev time.Duration) time.Duration {
+		if prev < base {
+			prev = base
+		}
+		upper := 3 * prev
+		if upper > max {
+			upper = max
+		}
+		if upper <= base {
+			return upper
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		return base + time.Duration(r.Int63n(int64(upper-base)))
+	})
+}
@@ -0,0 +1,160 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Yuukirn/gutils"
+)
+
+type Policy struct {
+	Backoff Backoff
+	// MaxAttempts limits the number of calls; zero or negative means unlimited.
+	MaxAttempts int
+	// MaxElapsed stops retrying once the next attempt would start after this
+	// much time since the first one; zero means no limit.
+	MaxElapsed time.Duration
+	// Retryable reports whether an error is worth retrying; nil retries every error.
+	Retryable func(err error) bool
+	// OnAttempt is called after every failed attempt.
+	OnAttempt func(a Attempt)
+	// Clock returns the current time; defaults to time.Now.
+	Clock func() time.Time
+	// Sleep waits for d or until ctx is done; defaults to a timer-based sleep.
+	Sleep func(ctx context.Context, d time.Duration) error
+}
+
+type Attempt struct {
+	Number  int
+	Err     error
+	Elapsed time.Duration
+	// Delay is the wait before the next attempt, or zero if there is none.
+	Delay time.Duration
+}
+
+// Error is returned when every attempt failed. It wraps the error of each attempt,
+// and the context error if ctx was done while waiting for the next one.
+type Error struct {
+	Errs []error
+	// CtxErr is the error of the context that ended the wait before the next
+	// attempt, or nil if retrying stopped for another reason.
+	CtxErr error
+}
+
+func (e *Error) Error() string {
+	var msgs = make([]string, 0, len(e.Errs))
+	for i, err := range e.Errs {
+		msgs = append(msgs, fmt.Sprintf("attempt %d: %v", i+1, err))
+	}
+	msg := fmt.Sprintf("retry: %d attempts failed: %s", len(e.Errs), strings.Join(msgs, "; "))
+	if e.CtxErr != nil {
+		msg += "; stopped: " + e.CtxErr.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() []error {
+	if e.CtxErr == nil {
+		return e.Errs
+	}
+	return append(e.Errs[:len(e.Errs):len(e.Errs)], e.CtxErr)
+}
+
+func (e *Error) Last() error {
+	return e.Errs[len(e.Errs)-1]
+}
+
+// Do calls f until it returns Ok, the error is not retryable, the attempts or
+// elapsed time are exhausted, or ctx is done.
+func Do[T any](ctx context.Context, p Policy, f func(ctx context.Context) gutils.Result[T]) gutils.Result[T] {
+	now := p.Clock
+	if now == nil {
+		now = time.Now
+	}
+	sleep := p.Sleep
+	if sleep == nil {
+		sleep = sleepCtx
+	}
+	backoff := p.Backoff
+	if backoff == nil {
+		backoff = Constant(0)
+	}
+
+	var (
+		start = now()
+		errs  []error
+		delay time.Duration
+	)
+	for attempt := 1; ; attempt++ {
+		r := f(ctx)
+		if r.IsOk() {
+			return r
+		}
+
+		err := r.ExpectErr("")
+		errs = append(errs, err)
+		a := Attempt{Number: attempt, Err: err, Elapsed: now().Sub(start)}
+
+		stop := (p.Retryable != nil && !p.Retryable(err)) ||
+			(p.MaxAttempts > 0 && attempt >= p.MaxAttempts)
+		if !stop {
+			delay = backoff.Next(attempt, delay)
+			stop = p.MaxElapsed > 0 && a.Elapsed+delay > p.MaxElapsed
+		}
+		if !stop {
+			a.Delay = delay
+		}
+		if p.OnAttempt != nil {
+			p.OnAttempt(a)
+		}
+		if stop {
+			return gutils.Err[T](&Error{Errs: errs})
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return gutils.Err[T](&Error{Errs: errs, CtxErr: err})
+		}
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Permanent marks err as not retryable for IsRetryable.
+func Permanent(err error) error {
+	return &permanentError{err}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// IsRetryable is a Retryable classifier that rejects errors marked Permanent
+// and context cancellation.
+func IsRetryable(err error) bool {
+	var pe *permanentError
+	return !errors.As(err, &pe) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,209 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Yuukirn/gutils"
+)
+
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return ctx.Err()
+}
+
+func failing(n int, errs ...error) func(ctx context.Context) gutils.Result[int] {
+	var calls int
+	return func(ctx context.Context) gutils.Result[int] {
+		calls++
+		if calls > n {
+			return gutils.Ok(calls)
+		}
+		return gutils.Err[int](errs[(calls-1)%len(errs)])
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	type testCase struct {
+		name    string
+		backoff Backoff
+		want    []time.Duration
+	}
+	tests := []testCase{
+		{
+			name:    "ConstantTest",
+			backoff: Constant(time.Second),
+			want:    []time.Duration{time.Second, time.Second, time.Second, time.Second},
+		},
+		{
+			name:    "ExponentialTest",
+			backoff: Exponential(time.Second, 5*time.Second, 2),
+			want:    []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []time.Duration
+			var prev time.Duration
+			for i := 1; i <= len(tt.want); i++ {
+				prev = tt.backoff.Next(i, prev)
+				got = append(got, prev)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecorrelatedJitter(t *testing.T) {
+	b := DecorrelatedJitter(time.Second, 10*time.Second, rand.New(rand.NewSource(1)))
+	var prev time.Duration
+	for i := 1; i <= 100; i++ {
+		d := b.Next(i, prev)
+		upper := 3 * prev
+		if upper < 3*time.Second {
+			upper = 3 * time.Second
+		}
+		if upper > 10*time.Second {
+			upper = 10 * time.Second
+		}
+		if d < time.Second || d > upper {
+			t.Fatalf("Next() = %v, want in [%v, %v]", d, time.Second, upper)
+		}
+		prev = d
+	}
+}
+
+func TestDo(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	type testCase struct {
+		name       string
+		policy     Policy
+		f          func(ctx context.Context) gutils.Result[int]
+		want       gutils.Option[int]
+		wantErrs   []error
+		wantSleeps []time.Duration
+	}
+	tests := []testCase{
+		{
+			name:       "DoTest1",
+			policy:     Policy{Backoff: Constant(time.Second), MaxAttempts: 5},
+			f:          failing(2, errA),
+			want:       gutils.Some(3),
+			wantSleeps: []time.Duration{time.Second, time.Second},
+		},
+		{
+			name:       "DoTest2",
+			policy:     Policy{Backoff: Exponential(time.Second, time.Minute, 2), MaxAttempts: 3},
+			f:          failing(5, errA, errB),
+			wantErrs:   []error{errA, errB, errA},
+			wantSleeps: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:       "DoTest3",
+			policy:     Policy{Backoff: Exponential(time.Second, time.Minute, 2), MaxElapsed: 5 * time.Second},
+			f:          failing(5, errA),
+			wantErrs:   []error{errA, errA, errA},
+			wantSleeps: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:     "DoTest4",
+			policy:   Policy{Backoff: Constant(time.Second), Retryable: IsRetryable},
+			f:        failing(5, Permanent(errA)),
+			wantErrs: []error{Permanent(errA)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Unix(0, 0)}
+			tt.policy.Clock = clock.Now
+			tt.policy.Sleep = clock.Sleep
+
+			got := Do(context.Background(), tt.policy, tt.f)
+			if !reflect.DeepEqual(got.Ok(), tt.want) {
+				t.Errorf("Do() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErrs != nil {
+				var re *Error
+				if !errors.As(got.ExpectErr(""), &re) || !reflect.DeepEqual(re.Errs, tt.wantErrs) {
+					t.Errorf("Do() = %v, want errors %v", got, tt.wantErrs)
+				}
+				if !got.IsErrIs(errA) {
+					t.Errorf("Do() = %v, want errors.Is %v", got, errA)
+				}
+			}
+			if !reflect.DeepEqual(clock.sleeps, tt.wantSleeps) {
+				t.Errorf("sleeps = %v, want %v", clock.sleeps, tt.wantSleeps)
+			}
+		})
+	}
+}
+
+func TestDo_OnAttempt(t *testing.T) {
+	errA := errors.New("a")
+	clock := &fakeClock{now: time.Unix(0, 0)}
+
+	var got []Attempt
+	Do(context.Background(), Policy{
+		Backoff:     Constant(time.Second),
+		MaxAttempts: 3,
+		OnAttempt:   func(a Attempt) { got = append(got, a) },
+		Clock:       clock.Now,
+		Sleep:       clock.Sleep,
+	}, failing(5, errA))
+
+	want := []Attempt{
+		{Number: 1, Err: errA, Elapsed: 0, Delay: time.Second},
+		{Number: 2, Err: errA, Elapsed: time.Second, Delay: time.Second},
+		{Number: 3, Err: errA, Elapsed: 2 * time.Second},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OnAttempt() = %v, want %v", got, want)
+	}
+}
+
+func TestDo_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got := Do(ctx, Policy{Backoff: Constant(time.Hour)}, failing(5, errors.New("a")))
+	if !got.IsErrIs(context.Canceled) {
+		t.Errorf("Do() = %v, want %v", got, context.Canceled)
+	}
+}
+
+func TestDo_CanceledDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sleep := func(ctx context.Context, d time.Duration) error {
+		cancel()
+		return ctx.Err()
+	}
+
+	got := Do(ctx, Policy{Backoff: Constant(time.Second), Sleep: sleep}, failing(5, errors.New("a")))
+	var re *Error
+	if !errors.As(got.ExpectErr(""), &re) || len(re.Errs) != 1 || re.CtxErr != context.Canceled {
+		t.Fatalf("Do() = %v, want one attempt error and %v", got, context.Canceled)
+	}
+	if !got.IsErrIs(context.Canceled) {
+		t.Errorf("Do() = %v, want %v", got, context.Canceled)
+	}
+	if want := "retry: 1 attempts failed: attempt 1: a; stopped: context canceled"; got.ExpectErr("").Error() != want {
+		t.Errorf("Error() = %v, want %v", got.ExpectErr(""), want)
+	}
+}
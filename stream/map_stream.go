@@ -6,6 +6,9 @@ import (
 	"maps"
 )
 
+// MapStream owns its backing map. Every operation except GetOrInsert returns a
+// new stream and leaves the receiver unchanged, so one stream can be the base of
+// several pipelines. GetOrInsert only ever changes the stream it is called on.
 type MapStream[K comparable, V any] struct {
 	m map[K]V
 }
@@ -16,19 +19,22 @@ func NewMapStream[K comparable, V any](m map[K]V) *MapStream[K, V] {
 	return &MapStream[K, V]{res}
 }
 
+func (ms *MapStream[K, V]) Clone() *MapStream[K, V] {
+	return NewMapStream(ms.m)
+}
+
 func (ms *MapStream[K, V]) Map(f func(K, V) (K, V)) *MapStream[K, V] {
-	ms.m = gm.Map(ms.m, f)
-	return ms
+	return &MapStream[K, V]{gm.Map(ms.m, f)}
 }
 
 func (ms *MapStream[K, V]) Filter(f func(K) bool) *MapStream[K, V] {
-	ms.m = gm.Filter(ms.m, f)
-	return ms
+	return &MapStream[K, V]{gm.Filter(ms.m, f)}
 }
 
 func (ms *MapStream[K, V]) Merge(m map[K]V) *MapStream[K, V] {
-	ms.m = gm.Merge(ms.m, m)
-	return ms
+	var res = make(map[K]V, len(ms.m)+len(m))
+	maps.Copy(res, ms.m)
+	return &MapStream[K, V]{gm.Merge(res, m)}
 }
 
 func (ms *MapStream[K, V]) Keys() []K {
@@ -67,6 +73,9 @@ func (ms *MapStream[K, V]) ContainsKey(k K) bool {
 	return gm.ContainsKey(ms.m, k)
 }
 
+// ToMap returns a copy of the entries; changing it does not affect the stream.
 func (ms *MapStream[K, V]) ToMap() map[K]V {
-	return ms.m
+	var res = make(map[K]V, len(ms.m))
+	maps.Copy(res, ms.m)
+	return res
 }
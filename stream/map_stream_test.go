@@ -0,0 +1,46 @@
+package stream
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMapStream_Branch(t *testing.T) {
+	base := NewMapStream(map[string]int{"a": 1, "b": 2})
+	a := base.Filter(func(k string) bool { return k == "a" })
+	b := base.Merge(map[string]int{"c": 3})
+	c := base.Map(func(k string, v int) (string, int) { return k + k, v * 10 })
+
+	tests := []struct {
+		name string
+		ms   *MapStream[string, int]
+		want map[string]int
+	}{
+		{name: "BaseTest", ms: base, want: map[string]int{"a": 1, "b": 2}},
+		{name: "FilterTest", ms: a, want: map[string]int{"a": 1}},
+		{name: "MergeTest", ms: b, want: map[string]int{"a": 1, "b": 2, "c": 3}},
+		{name: "MapTest", ms: c, want: map[string]int{"aa": 10, "bb": 20}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ms.ToMap(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapStream_ToMap(t *testing.T) {
+	ms := NewMapStream(map[string]int{"a": 1})
+	m := ms.ToMap()
+	m["b"] = 2
+	if want := map[string]int{"a": 1}; !reflect.DeepEqual(ms.ToMap(), want) {
+		t.Errorf("ToMap() = %v, want %v", ms.ToMap(), want)
+	}
+
+	clone := ms.Clone()
+	clone.GetOrInsert("c", 3)
+	if ms.ContainsKey("c") {
+		t.Errorf("Clone() shares state with the original stream")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/Yuukirn/gutils/gs"
 )
 
+// SliceStream owns its backing slice. Every operation returns a new stream and
+// leaves the receiver unchanged, so one stream can be the base of several pipelines.
 type SliceStream[T any] struct {
 	slice []T
 }
@@ -15,29 +17,32 @@ func NewSliceStream[T any](s []T) *SliceStream[T] {
 	return &SliceStream[T]{res}
 }
 
+func (ss *SliceStream[T]) Clone() *SliceStream[T] {
+	return NewSliceStream(ss.slice)
+}
+
 func (ss *SliceStream[T]) Filter(f func(T) bool) *SliceStream[T] {
-	ss.slice = gs.Filter(ss.slice, f)
-	return ss
+	return &SliceStream[T]{gs.Filter(ss.slice, f)}
 }
 
 func (ss *SliceStream[T]) Map(f func(T) T) *SliceStream[T] {
-	ss.slice = gs.Map(ss.slice, f)
-	return ss
+	return &SliceStream[T]{gs.Map(ss.slice, f)}
 }
 
 func (ss *SliceStream[T]) Reverse() *SliceStream[T] {
-	ss.slice = gs.Reverse(ss.slice)
-	return ss
+	return &SliceStream[T]{gs.Reverse(ss.slice)}
 }
 
 func (ss *SliceStream[T]) Append(s []T) *SliceStream[T] {
-	ss.slice = append(ss.slice, s...)
-	return ss
+	var res = make([]T, 0, len(ss.slice)+len(s))
+	res = append(res, ss.slice...)
+	return &SliceStream[T]{append(res, s...)}
 }
 
 func (ss *SliceStream[T]) Prepend(s []T) *SliceStream[T] {
-	ss.slice = append(s, ss.slice...)
-	return ss
+	var res = make([]T, 0, len(s)+len(ss.slice))
+	res = append(res, s...)
+	return &SliceStream[T]{append(res, ss.slice...)}
 }
 
 func (ss *SliceStream[T]) First() gutils.Option[T] {
@@ -63,8 +68,11 @@ func (ss *SliceStream[T]) Get(i int) gutils.Option[T] {
 	return gutils.Some(ss.slice[i])
 }
 
+// ToSlice returns a copy of the elements; changing it does not affect the stream.
 func (ss *SliceStream[T]) ToSlice() []T {
-	return ss.slice
+	var res = make([]T, len(ss.slice))
+	copy(res, ss.slice)
+	return res
 }
 
 func (ss *SliceStream[T]) Fold(f func(T, T) T) T {
@@ -74,3 +82,20 @@ func (ss *SliceStream[T]) Fold(f func(T, T) T) T {
 func (ss *SliceStream[T]) FoldWith(dv T, f func(T, T) T) T {
 	return gs.FoldWith(ss.slice, dv, f)
 }
+
+func (ss *SliceStream[T]) Find(f func(T) bool) gutils.Option[T] {
+	return gs.Find(ss.slice, f)
+}
+
+func (ss *SliceStream[T]) Any(f func(T) bool) bool {
+	return gs.Any(ss.slice, f)
+}
+
+func (ss *SliceStream[T]) All(f func(T) bool) bool {
+	return gs.All(ss.slice, f)
+}
+
+// Fold reduces the stream into an accumulator whose type may differ from the element type.
+func Fold[T, Acc any](ss *SliceStream[T], init Acc, f func(Acc, T) Acc) Acc {
+	return gs.Reduce(ss.slice, init, f)
+}
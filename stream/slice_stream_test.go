@@ -0,0 +1,92 @@
+package stream
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Yuukirn/gutils"
+)
+
+func TestSliceStream_Branch(t *testing.T) {
+	base := NewSliceStream([]int{1, 2, 3, 4})
+	a := base.Filter(func(t int) bool { return t%2 == 0 })
+	b := base.Map(func(t int) int { return t * 10 })
+	c := base.Append([]int{5}).Reverse()
+	d := base.Prepend([]int{0})
+
+	tests := []struct {
+		name string
+		ss   *SliceStream[int]
+		want []int
+	}{
+		{name: "BaseTest", ss: base, want: []int{1, 2, 3, 4}},
+		{name: "FilterTest", ss: a, want: []int{2, 4}},
+		{name: "MapTest", ss: b, want: []int{10, 20, 30, 40}},
+		{name: "AppendReverseTest", ss: c, want: []int{5, 4, 3, 2, 1}},
+		{name: "PrependTest", ss: d, want: []int{0, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ss.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSliceStream_ToSlice(t *testing.T) {
+	s := []int{1, 2, 3}
+	ss := NewSliceStream(s)
+	s[0] = 10
+
+	got := ss.ToSlice()
+	got[1] = 20
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(ss.ToSlice(), want) {
+		t.Errorf("ToSlice() = %v, want %v", ss.ToSlice(), want)
+	}
+
+	prefix := make([]int, 1, 10)
+	ss.Prepend(prefix)
+	if got := prefix[:2]; !reflect.DeepEqual(got, []int{0, 0}) {
+		t.Errorf("Prepend() changed its argument: %v", got)
+	}
+
+	clone := ss.Clone()
+	if clone == ss || !reflect.DeepEqual(clone.ToSlice(), ss.ToSlice()) {
+		t.Errorf("Clone() = %v, want copy of %v", clone.ToSlice(), ss.ToSlice())
+	}
+}
+
+func TestSliceStream_Find(t *testing.T) {
+	var calls int
+	even := func(t int) bool {
+		calls++
+		return t%2 == 0
+	}
+	ss := NewSliceStream([]int{1, 2, 3, 4})
+
+	if got := ss.Find(even); !reflect.DeepEqual(got, gutils.Some(2)) {
+		t.Errorf("Find() = %v, want %v", got, gutils.Some(2))
+	}
+	if calls != 2 {
+		t.Errorf("Find() called f %v times, want %v", calls, 2)
+	}
+	if got := ss.Any(even); !got {
+		t.Errorf("Any() = %v, want %v", got, true)
+	}
+
+	calls = 0
+	if got := ss.All(even); got {
+		t.Errorf("All() = %v, want %v", got, false)
+	}
+	if calls != 1 {
+		t.Errorf("All() called f %v times, want %v", calls, 1)
+	}
+}
+
+func TestFold(t *testing.T) {
+	ss := NewSliceStream([]string{"a", "bb", "ccc"})
+	if got := Fold(ss, 0, func(acc int, s string) int { return acc + len(s) }); got != 6 {
+		t.Errorf("Fold() = %v, want %v", got, 6)
+	}
+}
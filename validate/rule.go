@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/Yuukirn/gutils"
+	"golang.org/x/exp/constraints"
+)
+
+// Rule checks a single value. Any func(T) error can be used as a Rule;
+// errors that are not a *RuleError are reported with the code "invalid".
+type Rule[T any] func(t T) error
+
+type RuleError struct {
+	Code    string
+	Message string
+}
+
+func (e *RuleError) Error() string {
+	return e.Message
+}
+
+func Required[T comparable]() Rule[T] {
+	return func(t T) error {
+		if gutils.IsZero(t) {
+			return &RuleError{Code: "required", Message: "is required"}
+		}
+		return nil
+	}
+}
+
+func MinLen[T ~string](n int) Rule[T] {
+	return func(t T) error {
+		if utf8.RuneCountInString(string(t)) < n {
+			return &RuleError{Code: "min_len", Message: fmt.Sprintf("must be at least %d characters", n)}
+		}
+		return nil
+	}
+}
+
+func MaxLen[T ~string](n int) Rule[T] {
+	return func(t T) error {
+		if utf8.RuneCountInString(string(t)) > n {
+			return &RuleError{Code: "max_len", Message: fmt.Sprintf("must be at most %d characters", n)}
+		}
+		return nil
+	}
+}
+
+func Range[T constraints.Ordered](min, max T) Rule[T] {
+	return func(t T) error {
+		if t < min || t > max {
+			return &RuleError{Code: "range", Message: fmt.Sprintf("must be between %v and %v", min, max)}
+		}
+		return nil
+	}
+}
+
+func Matches[T ~string](re *regexp.Regexp) Rule[T] {
+	return func(t T) error {
+		if !re.MatchString(string(t)) {
+			return &RuleError{Code: "matches", Message: fmt.Sprintf("must match %s", re)}
+		}
+		return nil
+	}
+}
+
+func OneOf[T comparable](ts ...T) Rule[T] {
+	return func(t T) error {
+		for i := range ts {
+			if ts[i] == t {
+				return nil
+			}
+		}
+		return &RuleError{Code: "one_of", Message: fmt.Sprintf("must be one of %v", ts)}
+	}
+}
@@ -0,0 +1,229 @@
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/Yuukirn/gutils"
+)
+
+func TestRules(t *testing.T) {
+	type testCase struct {
+		name string
+		err  error
+		want string
+	}
+	tests := []testCase{
+		{name: "RequiredTest1", err: Required[string]()(""), want: "required"},
+		{name: "RequiredTest2", err: Required[string]()("foo"), want: ""},
+		{name: "MinLenTest1", err: MinLen[string](3)("ab"), want: "min_len"},
+		{name: "MinLenTest2", err: MinLen[string](3)("äöü"), want: ""},
+		{name: "MaxLenTest1", err: MaxLen[string](3)("abcd"), want: "max_len"},
+		{name: "MaxLenTest2", err: MaxLen[string](3)("abc"), want: ""},
+		{name: "RangeTest1", err: Range(1, 10)(0), want: "range"},
+		{name: "RangeTest2", err: Range(1, 10)(10), want: ""},
+		{name: "MatchesTest1", err: Matches[string](regexp.MustCompile(`^\d+$`))("12a"), want: "matches"},
+		{name: "MatchesTest2", err: Matches[string](regexp.MustCompile(`^\d+$`))("123"), want: ""},
+		{name: "OneOfTest1", err: OneOf("a", "b")("c"), want: "one_of"},
+		{name: "OneOfTest2", err: OneOf("a", "b")("b"), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			var re *RuleError
+			if errors.As(tt.err, &re) {
+				got = re.Code
+			}
+			if got != tt.want {
+				t.Errorf("Rule() code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	odd := Rule[int](func(t int) error {
+		if t%2 == 0 {
+			return errors.New("must be odd")
+		}
+		return nil
+	})
+	type testCase struct {
+		name string
+		got  Validated[int]
+		want Errors
+	}
+	tests := []testCase{
+		{
+			name: "CheckTest1",
+			got:  Check("age", 3, Range(1, 10), odd),
+			want: nil,
+		},
+		{
+			name: "CheckTest2",
+			got:  Check("age", 12, Range(1, 10), odd),
+			want: Errors{
+				{Field: "age", Code: "range", Message: "must be between 1 and 10"},
+				{Field: "age", Code: "invalid", Message: "must be odd"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.Errors(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+			if got := tt.got.IsValid(); got != (tt.want == nil) {
+				t.Errorf("IsValid() = %v, want %v", got, tt.want == nil)
+			}
+		})
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	type testCase struct {
+		name string
+		got  Validated[int]
+		want Errors
+	}
+	tests := []testCase{
+		{
+			name: "InvalidTest1",
+			got:  Invalid[int](FieldError{Field: "age", Code: "range", Message: "out of range"}),
+			want: Errors{{Field: "age", Code: "range", Message: "out of range"}},
+		},
+		{
+			name: "InvalidTest2",
+			got:  Invalid[int](),
+			want: Errors{{Code: "invalid", Message: "is invalid"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.IsValid() {
+				t.Errorf("IsValid() = true, want false")
+			}
+			if got := tt.got.Errors(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Invalid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMap2(t *testing.T) {
+	type pair struct {
+		Name string
+		Age  int
+	}
+	mk := func(n string, a int) pair { return pair{n, a} }
+
+	got := Map2(Check("name", "foo", Required[string]()), Check("age", 3, Range(1, 10)), mk)
+	if !reflect.DeepEqual(got.Value(), gutils.Some(pair{"foo", 3})) {
+		t.Errorf("Map2() = %v, want %v", got.Value(), gutils.Some(pair{"foo", 3}))
+	}
+
+	got = Map2(Check("name", "", Required[string]()), Check("age", 0, Range(1, 10)), mk)
+	want := Errors{
+		{Field: "name", Code: "required", Message: "is required"},
+		{Field: "age", Code: "range", Message: "must be between 1 and 10"},
+	}
+	if !reflect.DeepEqual(got.Errors(), want) {
+		t.Errorf("Map2() = %v, want %v", got.Errors(), want)
+	}
+	if got.Value().IsSome() {
+		t.Errorf("Value() = %v, want %v", got.Value(), gutils.None[pair]())
+	}
+}
+
+type address struct {
+	City string
+	Zip  string
+}
+
+type user struct {
+	Name    string
+	Age     int
+	Role    string
+	Tags    []string
+	Address address
+}
+
+func userValidator() *Validator[user] {
+	av := New[address]()
+	Field(av, "city", func(a address) string { return a.City }, Required[string]())
+	Field(av, "zip", func(a address) string { return a.Zip }, Matches[string](regexp.MustCompile(`^\d{5}$`)))
+
+	v := New[user]()
+	Field(v, "name", func(u user) string { return u.Name }, Required[string](), MinLen[string](2))
+	Field(v, "age", func(u user) int { return u.Age }, Range(0, 150))
+	Field(v, "role", func(u user) string { return u.Role }, OneOf("admin", "member"))
+	Each(v, "tags", func(u user) []string { return u.Tags }, MaxLen[string](3))
+	Nested(v, "address", func(u user) address { return u.Address }, av)
+	return v
+}
+
+func TestValidator_Validate(t *testing.T) {
+	type testCase struct {
+		name string
+		u    user
+		want Errors
+	}
+	tests := []testCase{
+		{
+			name: "ValidateTest1",
+			u:    user{Name: "foo", Age: 20, Role: "admin", Tags: []string{"a"}, Address: address{City: "x", Zip: "12345"}},
+			want: nil,
+		},
+		{
+			name: "ValidateTest2",
+			u:    user{Age: 200, Role: "root", Tags: []string{"a", "abcd"}, Address: address{Zip: "1"}},
+			want: Errors{
+				{Field: "name", Code: "required", Message: "is required"},
+				{Field: "name", Code: "min_len", Message: "must be at least 2 characters"},
+				{Field: "age", Code: "range", Message: "must be between 0 and 150"},
+				{Field: "role", Code: "one_of", Message: "must be one of [admin member]"},
+				{Field: "tags[1]", Code: "max_len", Message: "must be at most 3 characters"},
+				{Field: "address.city", Code: "required", Message: "is required"},
+				{Field: "address.zip", Code: "matches", Message: `must match ^\d{5}$`},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := userValidator().Validate(tt.u).Errors(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidated_ToResult(t *testing.T) {
+	r := userValidator().Validate(user{Name: "foo", Role: "member", Address: address{City: "x", Zip: "1"}}).ToResult()
+	if !r.IsErr() {
+		t.Fatalf("ToResult() = %v, want Err", r)
+	}
+
+	var errs Errors
+	if !errors.As(r.ExpectErr(""), &errs) {
+		t.Fatalf("ToResult() error = %T, want Errors", r.ExpectErr(""))
+	}
+	got, err := json.Marshal(errs)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `[{"field":"address.zip","code":"matches","message":"must match ^\\d{5}$"}]`
+	if string(got) != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+	if got := r.ExpectErr("").Error(); got != `address.zip: must match ^\d{5}$` {
+		t.Errorf("Error() = %v, want %v", got, `address.zip: must match ^\d{5}$`)
+	}
+
+	u := user{Name: "foo", Role: "member", Address: address{City: "x", Zip: "12345"}}
+	if r := userValidator().Validate(u).ToResult(); !reflect.DeepEqual(r, gutils.Ok(u)) {
+		t.Errorf("ToResult() = %v, want %v", r, gutils.Ok(u))
+	}
+}
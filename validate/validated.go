@@ -0,0 +1,125 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Yuukirn/gutils"
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// Errors is the multi-error of a failed validation. It marshals to a JSON array.
+type Errors []FieldError
+
+func (es Errors) Error() string {
+	var msgs = make([]string, 0, len(es))
+	for i := range es {
+		msgs = append(msgs, es[i].Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Validated holds either a valid value or every failure found while validating it.
+// Unlike Result, combining Validated values accumulates errors instead of
+// stopping at the first one.
+type Validated[T any] struct {
+	value T
+	errs  Errors
+}
+
+func Valid[T any](t T) Validated[T] {
+	return Validated[T]{value: t}
+}
+
+// Invalid returns a Validated failing with errs, or with a generic "invalid"
+// error if errs is empty, so that it is never valid.
+func Invalid[T any](errs ...FieldError) Validated[T] {
+	if len(errs) == 0 {
+		errs = Errors{{Code: "invalid", Message: "is invalid"}}
+	}
+	return Validated[T]{errs: errs}
+}
+
+// Check runs every rule against t and reports all failures under field.
+func Check[T any](field string, t T, rules ...Rule[T]) Validated[T] {
+	var errs Errors
+	for _, rule := range rules {
+		if err := rule(t); err != nil {
+			errs = append(errs, fieldError(field, err))
+		}
+	}
+	return Validated[T]{value: t, errs: errs}
+}
+
+func (v Validated[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+func (v Validated[T]) Value() gutils.Option[T] {
+	if !v.IsValid() {
+		return gutils.None[T]()
+	}
+	return gutils.Some(v.value)
+}
+
+func (v Validated[T]) Errors() Errors {
+	return v.errs
+}
+
+func (v Validated[T]) ToResult() gutils.Result[T] {
+	if !v.IsValid() {
+		return gutils.Err[T](v.errs)
+	}
+	return gutils.Ok(v.value)
+}
+
+func Map[T, U any](v Validated[T], f func(t T) U) Validated[U] {
+	if !v.IsValid() {
+		return Invalid[U](v.errs...)
+	}
+	return Valid(f(v.value))
+}
+
+func Map2[A, B, C any](a Validated[A], b Validated[B], f func(a A, b B) C) Validated[C] {
+	errs := concat(a.errs, b.errs)
+	if len(errs) > 0 {
+		return Invalid[C](errs...)
+	}
+	return Valid(f(a.value, b.value))
+}
+
+func Map3[A, B, C, D any](a Validated[A], b Validated[B], c Validated[C], f func(a A, b B, c C) D) Validated[D] {
+	errs := concat(a.errs, b.errs, c.errs)
+	if len(errs) > 0 {
+		return Invalid[D](errs...)
+	}
+	return Valid(f(a.value, b.value, c.value))
+}
+
+func concat(errs ...Errors) Errors {
+	var res Errors
+	for i := range errs {
+		res = append(res, errs[i]...)
+	}
+	return res
+}
+
+func fieldError(field string, err error) FieldError {
+	var re *RuleError
+	if errors.As(err, &re) {
+		return FieldError{Field: field, Code: re.Code, Message: re.Message}
+	}
+	return FieldError{Field: field, Code: "invalid", Message: err.Error()}
+}
@@ -0,0 +1,57 @@
+package validate
+
+import "strconv"
+
+// Validator validates the fields of a struct, reporting each failure with the
+// dotted path of the field, e.g. "address.city" or "items[2]".
+type Validator[S any] struct {
+	checks []func(s S, prefix string) Errors
+}
+
+func New[S any]() *Validator[S] {
+	return &Validator[S]{}
+}
+
+func Field[S, F any](v *Validator[S], path string, get func(s S) F, rules ...Rule[F]) *Validator[S] {
+	v.checks = append(v.checks, func(s S, prefix string) Errors {
+		return Check(joinPath(prefix, path), get(s), rules...).errs
+	})
+	return v
+}
+
+func Each[S, E any](v *Validator[S], path string, get func(s S) []E, rules ...Rule[E]) *Validator[S] {
+	v.checks = append(v.checks, func(s S, prefix string) Errors {
+		var errs Errors
+		for i, e := range get(s) {
+			errs = append(errs, Check(joinPath(prefix, path)+"["+strconv.Itoa(i)+"]", e, rules...).errs...)
+		}
+		return errs
+	})
+	return v
+}
+
+func Nested[S, N any](v *Validator[S], path string, get func(s S) N, nv *Validator[N]) *Validator[S] {
+	v.checks = append(v.checks, func(s S, prefix string) Errors {
+		return nv.validate(get(s), joinPath(prefix, path))
+	})
+	return v
+}
+
+func (v *Validator[S]) Validate(s S) Validated[S] {
+	return Validated[S]{value: s, errs: v.validate(s, "")}
+}
+
+func (v *Validator[S]) validate(s S, prefix string) Errors {
+	var errs Errors
+	for _, check := range v.checks {
+		errs = append(errs, check(s, prefix)...)
+	}
+	return errs
+}
+
+func joinPath(prefix, path string) string {
+	if prefix == "" {
+		return path
+	}
+	return prefix + "." + path
+}